@@ -0,0 +1,145 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+type AccountParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+}
+
+func (a *AccountParams) GetEncoded() (string, error) {
+	q, err := query.Values(a)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (a *AccountParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("user")
+	encoded, err := a.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// Account is the subscription of the API token. ApiRequests counts the calls made on ApiRequestsDate.
+type Account struct {
+	Name             string `json:"name"`
+	Email            string `json:"email"`
+	SubscriptionType string `json:"subscriptionType"`
+	PaymentMethod    string `json:"paymentMethod"`
+	ApiRequests      int    `json:"apiRequests"`
+	ApiRequestsDate  Date   `json:"apiRequestsDate"`
+	DailyRateLimit   int    `json:"dailyRateLimit"`
+	ExtraLimit       int    `json:"extraLimit"`
+}
+
+// DailyLimit is the total number of calls allowed today, including purchased extra calls.
+func (a *Account) DailyLimit() int {
+	return a.DailyRateLimit + a.ExtraLimit
+}
+
+type AccountService struct {
+	c RequestClient
+}
+
+func NewAccountService(c RequestClient) *AccountService {
+	return &AccountService{
+		c: c,
+	}
+}
+
+func (a *AccountService) GetAccount() (*Account, *Response, error) {
+	params := &AccountParams{
+		ApiToken: a.c.GetApiToken(),
+		Format:   formatJson,
+	}
+
+	u, err := params.BuildPath(a.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := a.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	// The user endpoint does not count against the quota
+	req = withCallCost(req, 0)
+
+	data := new(Account)
+	res, err := a.c.Do(req, data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// SyncQuota replaces the local quota accounting with the numbers reported by the user endpoint.
+// A request count from a previous day is taken as nothing used today.
+func (c *Client) SyncQuota() error {
+	account, _, err := c.AccountService.GetAccount()
+	if err != nil {
+		c.setQuotaSyncErr(err)
+		return err
+	}
+	if account.ApiRequestsDate.IsZero() {
+		c.quota.Set(account.DailyLimit(), account.ApiRequests)
+	} else {
+		c.quota.SetAsOf(account.DailyLimit(), account.ApiRequests, account.ApiRequestsDate.Time)
+	}
+	c.setQuotaSyncErr(nil)
+	return nil
+}
+
+// QuotaSyncErr returns the error of the last quota sync, or nil if it succeeded.
+func (c *Client) QuotaSyncErr() error {
+	c.quotaSyncMu.Lock()
+	defer c.quotaSyncMu.Unlock()
+	return c.quotaSyncErr
+}
+
+func (c *Client) setQuotaSyncErr(err error) {
+	c.quotaSyncMu.Lock()
+	defer c.quotaSyncMu.Unlock()
+	c.quotaSyncErr = err
+}
+
+// startQuotaSync refreshes the quota every interval until Close is called.
+func (c *Client) startQuotaSync(interval time.Duration) {
+	c.quotaSyncStop = make(chan struct{})
+	ticker := time.NewTicker(interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				_ = c.SyncQuota()
+			case <-c.quotaSyncStop:
+				return
+			}
+		}
+	}()
+}
+
+// Close stops the background quota sync, if any.
+func (c *Client) Close() {
+	c.closeOnce.Do(func() {
+		if c.quotaSyncStop != nil {
+			close(c.quotaSyncStop)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestAccountParams_BuildPath(t *testing.T) {
+	p := &AccountParams{ApiToken: "test-token", Format: formatJson}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/user?api_token=test-token&fmt=json"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestClient_SyncQuota(t *testing.T) {
+	requestsDate := time.Now().UTC().Format(urlDateFormat)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"Test","subscriptionType":"commercial","apiRequests":1200,"apiRequestsDate":"` +
+			requestsDate + `","dailyRateLimit":100000,"extraLimit":500}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", SetDailyQuota(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	if err = c.setBaseUrl(server.URL); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.SyncQuota(); err != nil {
+		t.Fatal(err)
+	}
+	q := c.GetQuota()
+	if q.DailyLimit() != 100500 || q.Used() != 1200 {
+		t.Errorf("expected quota 1200 of 100500, got %d of %d", q.Used(), q.DailyLimit())
+	}
+
+	requestsDate = "2024-05-10"
+	if err = c.SyncQuota(); err != nil {
+		t.Fatal(err)
+	}
+	if q.Used() != 0 {
+		t.Errorf("expected a stale request count to be taken as 0 used, got %d", q.Used())
+	}
+}
+
+func TestNewClient_QuotaSyncFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`Unauthenticated`))
+	}))
+	defer server.Close()
+
+	setBaseUrl := func(c *Client) error { return c.setBaseUrl(server.URL) }
+	c, err := NewClient("test-token", setBaseUrl, SetDailyQuota(10), SetQuotaSync(0))
+	if err != nil {
+		t.Fatalf("expected the client despite the failed sync, got %s", err)
+	}
+	defer c.Close()
+	if c.QuotaSyncErr() == nil {
+		t.Error("expected the startup sync error to be recorded")
+	}
+	if c.GetQuota().DailyLimit() != 10 || c.GetQuota().Used() != 0 {
+		t.Errorf("expected the local quota to be kept, got %d of %d", c.GetQuota().Used(), c.GetQuota().DailyLimit())
+	}
+}
@@ -0,0 +1,354 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"math"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type BondParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	// Identifier is an ISIN or a CUSIP
+	Identifier string `url:"-"`
+}
+
+func (b *BondParams) GetEncoded() (string, error) {
+	q, err := query.Values(b)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (b *BondParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("bond-fundamentals/%s", b.Identifier)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := b.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type BondClassification struct {
+	BondType            string `json:"BondType"`
+	DebtType            string `json:"DebtType"`
+	IndustryGroup       string `json:"IndustryGroup"`
+	IndustrySubGroup    string `json:"IndustrySubGroup"`
+	SubProductAsset     string `json:"SubProductAsset"`
+	SubProductAssetType string `json:"SubProductAssetType"`
+}
+
+type BondRating struct {
+	MoodyRating           string `json:"MoodyRating"`
+	MoodyRatingUpdateDate Date   `json:"MoodyRatingUpdateDate"`
+	SPRating              string `json:"SPRating"`
+	SPRatingUpdateDate    Date   `json:"SPRatingUpdateDate"`
+}
+
+type BondIssueData struct {
+	IssueDate              Date   `json:"IssueDate"`
+	OfferingDate           Date   `json:"OfferingDate"`
+	FirstCouponDate        Date   `json:"FirstCouponDate"`
+	FirstTradingDay        Date   `json:"FirstTradingDay"`
+	CouponPaymentFrequency string `json:"CouponPaymentFrequency"`
+}
+
+type BondIssuerData struct {
+	IssuerName    string `json:"IssuerName"`
+	IssuerCountry string `json:"IssuerCountry"`
+	IssuerWebsite string `json:"IssuerWebsite"`
+	CUSIP         string `json:"CUSIP"`
+}
+
+type BondCall struct {
+	Date  Date      `json:"Date"`
+	Price FlexFloat `json:"Price"`
+}
+
+type BondCallSchedule []*BondCall
+
+func (c *BondCallSchedule) UnmarshalJSON(b []byte) error {
+	calls := make(BondCallSchedule, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		call := new(BondCall)
+		if err := dec.Decode(call); err != nil {
+			return err
+		}
+		calls = append(calls, call)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*c = calls
+	return nil
+}
+
+// BondFundamentals is the static and pricing data of a bond. Coupon and YieldToMaturity are in percent.
+type BondFundamentals struct {
+	Code                    string             `json:"Code"`
+	Name                    string             `json:"Name"`
+	Country                 string             `json:"Country"`
+	Currency                string             `json:"Currency"`
+	ISIN                    string             `json:"ISIN"`
+	CUSIP                   string             `json:"CUSIP"`
+	FIGI                    string             `json:"FIGI"`
+	Coupon                  FlexFloat          `json:"Coupon"`
+	Price                   FlexFloat          `json:"Price"`
+	LastTradeDate           Date               `json:"LastTradeDate"`
+	MaturityDate            Date               `json:"Maturity_Date"`
+	YieldToMaturity         FlexFloat          `json:"YieldToMaturity"`
+	Callable                string             `json:"Callable"`
+	NextCallDate            Date               `json:"NextCallDate"`
+	MinimumSettlementAmount FlexFloat          `json:"MinimumSettlementAmount"`
+	ParIntegralMultiple     FlexFloat          `json:"ParIntegralMultiple"`
+	Classification          BondClassification `json:"ClassificationData"`
+	Rating                  BondRating         `json:"Rating"`
+	IssueData               BondIssueData      `json:"IssueData"`
+	IssuerData              BondIssuerData     `json:"IssuerData"`
+	CallSchedule            BondCallSchedule   `json:"CallSchedule"`
+}
+
+// IsCallable reports whether the issuer can redeem the bond early.
+func (b *BondFundamentals) IsCallable() bool {
+	return strings.EqualFold(b.Callable, "yes") || strings.EqualFold(b.Callable, "true")
+}
+
+// Terms returns the cash flow terms of the bond for a face value of 100.
+// It fails with ErrNoCouponFrequency when the API has no frequency, use TermsWithFrequency then.
+func (b *BondFundamentals) Terms(dayCount DayCount) (*BondTerms, error) {
+	frequency, err := ParseCouponFrequency(b.IssueData.CouponPaymentFrequency)
+	if err != nil {
+		return nil, err
+	}
+	return b.TermsWithFrequency(dayCount, frequency)
+}
+
+// TermsWithFrequency is Terms with the number of coupons per year supplied by the caller.
+func (b *BondFundamentals) TermsWithFrequency(dayCount DayCount, frequency int) (*BondTerms, error) {
+	if b.MaturityDate.IsZero() {
+		return nil, errors.New("bond has no maturity date")
+	}
+	if frequency <= 0 {
+		return nil, fmt.Errorf("invalid coupon frequency %d", frequency)
+	}
+	return &BondTerms{
+		Coupon:    float64(b.Coupon),
+		Maturity:  b.MaturityDate.Time,
+		Frequency: frequency,
+		DayCount:  dayCount,
+		Face:      100,
+	}, nil
+}
+
+type BondService struct {
+	c RequestClient
+}
+
+func NewBondService(c RequestClient) *BondService {
+	return &BondService{
+		c: c,
+	}
+}
+
+// GetBondFundamentals returns the fundamentals of a bond identified by ISIN or CUSIP.
+func (b *BondService) GetBondFundamentals(identifier string) (*BondFundamentals, *Response, error) {
+	if identifier == "" {
+		return nil, nil, errors.New("identifier is required")
+	}
+	params := &BondParams{
+		ApiToken:   b.c.GetApiToken(),
+		Format:     formatJson,
+		Identifier: identifier,
+	}
+
+	u, err := params.BuildPath(b.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := b.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(BondFundamentals)
+	res, err := b.c.Do(req, data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+type DayCount string
+
+const (
+	DayCount30360  DayCount = "30/360"
+	DayCountActAct DayCount = "ACT/ACT"
+	DayCountAct360 DayCount = "ACT/360"
+	DayCountAct365 DayCount = "ACT/365"
+)
+
+// ErrNoCouponFrequency is returned when a bond does not report how often it pays its coupon.
+var ErrNoCouponFrequency = errors.New("eodhd: bond has no coupon frequency")
+
+// ParseCouponFrequency converts a frequency such as Semi-Annual into coupons per year.
+func ParseCouponFrequency(frequency string) (int, error) {
+	switch strings.ToLower(strings.ReplaceAll(strings.TrimSpace(frequency), " ", "-")) {
+	case "":
+		return 0, ErrNoCouponFrequency
+	case "annual", "annually", "1":
+		return 1, nil
+	case "semi-annual", "semiannual", "semi-annually", "2":
+		return 2, nil
+	case "quarterly", "4":
+		return 4, nil
+	case "monthly", "12":
+		return 12, nil
+	}
+	return 0, fmt.Errorf("unknown coupon frequency %q", frequency)
+}
+
+// BondTerms are the cash flow terms used to price a fixed coupon bond offline.
+// Coupon is the annual rate in percent and prices are per Face.
+type BondTerms struct {
+	Coupon    float64
+	Maturity  time.Time
+	Frequency int
+	DayCount  DayCount
+	Face      float64
+}
+
+// CouponPeriod returns the coupon dates before and on or after settlement, stepping back from maturity.
+func (t *BondTerms) CouponPeriod(settlement time.Time) (time.Time, time.Time, error) {
+	n, err := t.remainingCoupons(settlement)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return t.couponDate(n), t.couponDate(n - 1), nil
+}
+
+// remainingCoupons counts the coupons paid after settlement.
+func (t *BondTerms) remainingCoupons(settlement time.Time) (int, error) {
+	if t.Frequency <= 0 || 12%t.Frequency != 0 {
+		return 0, errors.New("frequency must be 1, 2, 3, 4, 6 or 12")
+	}
+	if !settlement.Before(t.Maturity) {
+		return 0, errors.New("settlement must be before maturity")
+	}
+	n := 1
+	for t.couponDate(n).After(settlement) {
+		n++
+	}
+	return n, nil
+}
+
+// couponDate returns the coupon date n periods before maturity.
+// Dates are always derived from maturity so that month ends do not drift.
+func (t *BondTerms) couponDate(n int) time.Time {
+	m := t.Maturity
+	first := time.Date(m.Year(), m.Month(), 1, 0, 0, 0, 0, m.Location()).AddDate(0, -12/t.Frequency*n, 0)
+	// clamp to the end of shorter months instead of overflowing into the next one
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := m.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, m.Location())
+}
+
+// AccruedInterest returns the coupon accrued from the last coupon date up to settlement.
+func (t *BondTerms) AccruedInterest(settlement time.Time) (float64, error) {
+	prev, next, err := t.CouponPeriod(settlement)
+	if err != nil {
+		return 0, err
+	}
+	frac, err := t.periodFraction(prev, settlement, next)
+	if err != nil {
+		return 0, err
+	}
+	return t.Face * t.Coupon / 100 / float64(t.Frequency) * frac, nil
+}
+
+// DirtyPrice discounts the remaining cash flows at ytm, the annual yield in percent compounded at the coupon frequency.
+func (t *BondTerms) DirtyPrice(ytm float64, settlement time.Time) (float64, error) {
+	n, err := t.remainingCoupons(settlement)
+	if err != nil {
+		return 0, err
+	}
+	frac, err := t.periodFraction(t.couponDate(n), settlement, t.couponDate(n-1))
+	if err != nil {
+		return 0, err
+	}
+
+	f := float64(t.Frequency)
+	coupon := t.Face * t.Coupon / 100 / f
+	rate := 1 + ytm/100/f
+	w := 1 - frac
+
+	price := 0.0
+	for k := 0; k < n; k++ {
+		cf := coupon
+		if k == n-1 {
+			cf += t.Face
+		}
+		price += cf / math.Pow(rate, w+float64(k))
+	}
+	return price, nil
+}
+
+// CleanPrice is the dirty price at ytm less accrued interest.
+func (t *BondTerms) CleanPrice(ytm float64, settlement time.Time) (float64, error) {
+	dirty, err := t.DirtyPrice(ytm, settlement)
+	if err != nil {
+		return 0, err
+	}
+	accrued, err := t.AccruedInterest(settlement)
+	if err != nil {
+		return 0, err
+	}
+	return dirty - accrued, nil
+}
+
+// periodFraction returns the share of the coupon period prev..next elapsed at settlement under the day count.
+func (t *BondTerms) periodFraction(prev, settlement, next time.Time) (float64, error) {
+	actual := func(a, b time.Time) float64 { return b.Sub(a).Hours() / 24 }
+	f := float64(t.Frequency)
+	switch t.DayCount {
+	case DayCount30360, "":
+		return days360(prev, settlement) / (360 / f), nil
+	case DayCountActAct:
+		return actual(prev, settlement) / actual(prev, next), nil
+	case DayCountAct360:
+		return actual(prev, settlement) / (360 / f), nil
+	case DayCountAct365:
+		return actual(prev, settlement) / (365 / f), nil
+	}
+	return 0, fmt.Errorf("unknown day count %q", t.DayCount)
+}
+
+// days360 counts days between a and b under the US 30/360 convention.
+func days360(a, b time.Time) float64 {
+	d1, d2 := a.Day(), b.Day()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return float64(360*(b.Year()-a.Year()) + 30*(int(b.Month())-int(a.Month())) + d2 - d1)
+}
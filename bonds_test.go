@@ -0,0 +1,91 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestBondParams_BuildPath(t *testing.T) {
+	p := &BondParams{
+		ApiToken:   "test-token",
+		Format:     formatJson,
+		Identifier: "US912828Z781",
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/bond-fundamentals/US912828Z781?api_token=test-token&fmt=json"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestBondTerms_Pricing(t *testing.T) {
+	body := []byte(`{
+		"ISIN":"US0000000001","Coupon":"6.000","YieldToMaturity":"6.0","Maturity_Date":"2030-01-15","Callable":"No",
+		"IssueData":{"CouponPaymentFrequency":"Semi-Annual"},
+		"Rating":{"SPRating":"AA+"}
+	}`)
+	var bond BondFundamentals
+	if err := json.Unmarshal(body, &bond); err != nil {
+		t.Fatal(err)
+	}
+	terms, err := bond.Terms(DayCount30360)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	missing := bond
+	missing.IssueData.CouponPaymentFrequency = ""
+	if _, err = missing.Terms(DayCount30360); !errors.Is(err, ErrNoCouponFrequency) {
+		t.Errorf("expected ErrNoCouponFrequency, got %v", err)
+	}
+	if quarterly, err := missing.TermsWithFrequency(DayCount30360, 4); err != nil || quarterly.Frequency != 4 {
+		t.Errorf("unexpected terms %+v %v", quarterly, err)
+	}
+
+	settlement := time.Date(2024, 4, 15, 0, 0, 0, 0, time.UTC)
+	prev, next, err := terms.CouponPeriod(settlement)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev.Format(urlDateFormat) != "2024-01-15" || next.Format(urlDateFormat) != "2024-07-15" {
+		t.Errorf("unexpected coupon period %s - %s", prev, next)
+	}
+
+	// 90 of 180 days have accrued on a 3.00 coupon
+	accrued, _ := terms.AccruedInterest(settlement)
+	if math.Abs(accrued-1.5) > 1e-9 {
+		t.Errorf("expected accrued interest 1.5, got %f", accrued)
+	}
+
+	// a bond yielding its coupon prices at par on a coupon date
+	clean, err := terms.CleanPrice(6, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(clean-100) > 1e-9 {
+		t.Errorf("expected clean price 100, got %f", clean)
+	}
+
+	eom := &BondTerms{Coupon: 5, Maturity: time.Date(2030, 8, 31, 0, 0, 0, 0, time.UTC), Frequency: 2, Face: 100}
+	prev, _, _ = eom.CouponPeriod(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))
+	if prev.Format(urlDateFormat) != "2024-02-29" {
+		t.Errorf("expected month end coupon 2024-02-29, got %s", prev.Format(urlDateFormat))
+	}
+
+	dirty, _ := terms.DirtyPrice(6, settlement)
+	clean, _ = terms.CleanPrice(6, settlement)
+	if math.Abs(dirty-clean-accrued) > 1e-9 {
+		t.Errorf("expected dirty - clean to equal accrued interest")
+	}
+}
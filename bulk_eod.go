@@ -4,9 +4,17 @@
 package eodhd
 
 import (
+	"errors"
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"net/url"
+	"time"
+)
+
+const (
+	BulkEodTypeSplits     = "splits"
+	BulkEodTypeDividends  = "dividends"
+	BulkEodFilterExtended = "extended"
 )
 
 type BulkEod struct {
@@ -21,10 +29,71 @@ type BulkEod struct {
 	Volume        int     `csv:"Volume" json:"volume"`
 }
 
+// BulkEodExtended is a bulk EOD row requested with filter=extended.
+type BulkEodExtended struct {
+	Code                 string  `csv:"Code" json:"code"`
+	Name                 string  `csv:"Name" json:"name"`
+	Type                 string  `csv:"Type" json:"type"`
+	Exchange             string  `csv:"Ex" json:"exchange_short_name"`
+	Date                 string  `csv:"Date" json:"date"`
+	MarketCapitalization float64 `csv:"MarketCapitalization" json:"MarketCapitalization"`
+	Beta                 float64 `csv:"Beta" json:"Beta"`
+	Open                 float64 `csv:"Open" json:"open"`
+	High                 float64 `csv:"High" json:"high"`
+	Low                  float64 `csv:"Low" json:"low"`
+	Close                float64 `csv:"Close" json:"close"`
+	AdjustedClose        float64 `csv:"Adjusted_close" json:"adjusted_close"`
+	Volume               int     `csv:"Volume" json:"volume"`
+	Ema50                float64 `csv:"EMA_50" json:"ema_50d"`
+	Ema200               float64 `csv:"EMA_200" json:"ema_200d"`
+	High250              float64 `csv:"High_250" json:"hi_250d"`
+	Low250               float64 `csv:"Low_250" json:"lo_250d"`
+	PrevClose            float64 `csv:"Prev_close" json:"prev_close"`
+	Change               float64 `csv:"Change" json:"change"`
+	ChangePercent        float64 `csv:"Change_%" json:"change_p"`
+	AvgVolume14          float64 `csv:"Avgvol_14d" json:"avgvol_14d"`
+	AvgVolume50          float64 `csv:"Avgvol_50d" json:"avgvol_50d"`
+	AvgVolume200         float64 `csv:"Avgvol_200d" json:"avgvol_200d"`
+}
+
+// BulkSplit is a bulk row requested with type=splits.
+type BulkSplit struct {
+	Code     string `csv:"Code" json:"code"`
+	Exchange string `csv:"Ex" json:"exchange"`
+	Date     string `csv:"Date" json:"date"`
+	Split    string `csv:"Split" json:"split"`
+}
+
+// BulkDividend is a bulk row requested with type=dividends.
+type BulkDividend struct {
+	Code            string  `csv:"Code" json:"code"`
+	Exchange        string  `csv:"Ex" json:"exchange"`
+	Date            string  `csv:"Date" json:"date"`
+	Dividend        float64 `csv:"Dividend" json:"dividend"`
+	Currency        string  `csv:"Currency" json:"currency"`
+	DeclarationDate string  `csv:"DeclarationDate" json:"declarationDate"`
+	RecordDate      string  `csv:"RecordDate" json:"recordDate"`
+	PaymentDate     string  `csv:"PaymentDate" json:"paymentDate"`
+	Period          string  `csv:"Period" json:"period"`
+	UnadjustedValue float64 `csv:"UnadjustedValue" json:"unadjustedValue"`
+}
+
+// BulkEodOptions holds the optional query parameters of the bulk endpoint.
+type BulkEodOptions struct {
+	// Date requests a historical day instead of the last trading day.
+	Date *time.Time
+	// Symbols limits the response to the given tickers.
+	Symbols []string
+}
+
 type BulkEodParams struct {
 	ApiToken string        `url:"api_token"`
 	Format   RequestFormat `url:"fmt"`
 	Exchange string        `url:"-"`
+	Date     *string       `url:"date,omitempty"`
+	Symbols  []string      `url:"symbols,comma,omitempty"`
+	Type     *string       `url:"type,omitempty"`
+	Filter   *string       `url:"filter,omitempty"`
 }
 
 func NewBulkEodParams(apiToken string, exchange *string, format *RequestFormat) *BulkEodParams {
@@ -51,7 +120,22 @@ func NewBulkEodParams(apiToken string, exchange *string, format *RequestFormat)
 	}
 }
 
+// SetOptions applies the date and symbol options to the params.
+func (b *BulkEodParams) SetOptions(opts *BulkEodOptions) {
+	if opts == nil {
+		return
+	}
+	if opts.Date != nil {
+		date := opts.Date.Format(urlDateFormat)
+		b.Date = &date
+	}
+	b.Symbols = opts.Symbols
+}
+
 func (b *BulkEodParams) GetEncoded() (string, error) {
+	if b.Type != nil && b.Filter != nil {
+		return "", errors.New("filter can not be combined with type")
+	}
 	q, err := query.Values(b)
 	if err != nil {
 		return "", err
@@ -83,22 +167,78 @@ func NewBulkEodService(c RequestClient) *BulkEodService {
 }
 
 func (b *BulkEodService) GetBulkEod(exchange *string, format *RequestFormat) ([]*BulkEod, *Response, error) {
+	return b.GetBulkEodWithOptions(exchange, format, nil)
+}
+
+// GetBulkEodWithOptions fetches bulk EOD prices for a historical date and/or a subset of symbols.
+func (b *BulkEodService) GetBulkEodWithOptions(exchange *string, format *RequestFormat, opts *BulkEodOptions) ([]*BulkEod, *Response, error) {
 	params := NewBulkEodParams(b.c.GetApiToken(), exchange, format)
-	u, err := params.BuildPath(b.c.GetBaseUrl())
+	params.SetOptions(opts)
+
+	var data []*BulkEod
+	res, err := b.get(params, &data)
 	if err != nil {
-		return nil, nil, err
+		return nil, res, err
 	}
 
-	req, err := b.c.NewGetRequest(u, nil)
+	return data, res, nil
+}
+
+// GetBulkEodExtended fetches bulk EOD prices with filter=extended.
+func (b *BulkEodService) GetBulkEodExtended(exchange *string, format *RequestFormat, opts *BulkEodOptions) ([]*BulkEodExtended, *Response, error) {
+	params := NewBulkEodParams(b.c.GetApiToken(), exchange, format)
+	params.SetOptions(opts)
+	params.Filter = GetPtrString(BulkEodFilterExtended)
+
+	var data []*BulkEodExtended
+	res, err := b.get(params, &data)
 	if err != nil {
-		return nil, nil, err
+		return nil, res, err
 	}
 
-	var data []*BulkEod
-	res, err := b.c.Do(req, &data)
+	return data, res, nil
+}
+
+// GetBulkSplits fetches the splits of an exchange with type=splits.
+func (b *BulkEodService) GetBulkSplits(exchange *string, format *RequestFormat, opts *BulkEodOptions) ([]*BulkSplit, *Response, error) {
+	params := NewBulkEodParams(b.c.GetApiToken(), exchange, format)
+	params.SetOptions(opts)
+	params.Type = GetPtrString(BulkEodTypeSplits)
+
+	var data []*BulkSplit
+	res, err := b.get(params, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// GetBulkDividends fetches the dividends of an exchange with type=dividends.
+func (b *BulkEodService) GetBulkDividends(exchange *string, format *RequestFormat, opts *BulkEodOptions) ([]*BulkDividend, *Response, error) {
+	params := NewBulkEodParams(b.c.GetApiToken(), exchange, format)
+	params.SetOptions(opts)
+	params.Type = GetPtrString(BulkEodTypeDividends)
+
+	var data []*BulkDividend
+	res, err := b.get(params, &data)
 	if err != nil {
 		return nil, res, err
 	}
 
 	return data, res, nil
 }
+
+func (b *BulkEodService) get(params *BulkEodParams, data interface{}) (*Response, error) {
+	u, err := params.BuildPath(b.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := b.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.c.Do(req, data)
+}
@@ -6,6 +6,7 @@ package eodhd
 import (
 	"net/url"
 	"testing"
+	"time"
 )
 
 func TestBulkEodParams_BuildPath(t *testing.T) {
@@ -21,3 +22,26 @@ func TestBulkEodParams_BuildPath(t *testing.T) {
 		t.Errorf("expected %s, got %s", expected, u)
 	}
 }
+
+func TestBulkEodParams_SetOptions(t *testing.T) {
+	baseURL, _ := url.Parse("https://eodhd.com/api")
+	p := NewBulkEodParams("test-token", GetPtrString("US"), GetFormatJson())
+	p.SetOptions(&BulkEodOptions{
+		Date:    GetPtrTime(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)),
+		Symbols: []string{"AAPL", "MSFT"},
+	})
+	p.Type = GetPtrString(BulkEodTypeDividends)
+	u, err := p.BuildPath(baseURL)
+	if err != nil {
+		t.Error(err)
+	}
+	expected := "https://eodhd.com/api/eod-bulk-last-day/US?api_token=test-token&date=2024-03-15&fmt=json&symbols=AAPL%2CMSFT&type=dividends"
+	if expected != u {
+		t.Errorf("expected %s, got %s", expected, u)
+	}
+
+	p.Filter = GetPtrString(BulkEodFilterExtended)
+	if _, err = p.BuildPath(baseURL); err == nil {
+		t.Error("expected error combining type and filter")
+	}
+}
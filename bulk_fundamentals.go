@@ -0,0 +1,150 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+)
+
+const (
+	BulkFundamentalsMaxLimit = 500
+	// Each bulk fundamentals request is billed as 100 API calls regardless of page size.
+	BulkFundamentalsCallCost = 100
+)
+
+type BulkFundamentalsParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Exchange string        `url:"-"`
+	Offset   int           `url:"offset"`
+	Limit    int           `url:"limit"`
+	Symbols  []string      `url:"symbols,comma,omitempty"`
+}
+
+func NewBulkFundamentalsParams(apiToken, exchange string, offset, limit int, symbols []string) (*BulkFundamentalsParams, error) {
+	if exchange == "" {
+		return nil, errors.New("exchange is required")
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	if limit <= 0 {
+		limit = BulkFundamentalsMaxLimit
+	}
+	if limit > BulkFundamentalsMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", BulkFundamentalsMaxLimit)
+	}
+	return &BulkFundamentalsParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Exchange: exchange,
+		Offset:   offset,
+		Limit:    limit,
+		Symbols:  symbols,
+	}, nil
+}
+
+func (b *BulkFundamentalsParams) GetEncoded() (string, error) {
+	q, err := query.Values(b)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (b *BulkFundamentalsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("bulk-fundamentals/%s", b.Exchange)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := b.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// BulkFundamentalsPage is one page of bulk fundamentals.
+// The API returns an object keyed by the row index, which is decoded in order one entry at a time.
+type BulkFundamentalsPage []*Fundamentals
+
+func (p *BulkFundamentalsPage) UnmarshalJSON(b []byte) error {
+	page := make(BulkFundamentalsPage, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		f := new(Fundamentals)
+		if err := dec.Decode(f); err != nil {
+			return err
+		}
+		page = append(page, f)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*p = page
+	return nil
+}
+
+type BulkFundamentalsService struct {
+	c RequestClient
+}
+
+func NewBulkFundamentalsService(c RequestClient) *BulkFundamentalsService {
+	return &BulkFundamentalsService{
+		c: c,
+	}
+}
+
+// GetBulkFundamentals fetches a single page of fundamentals for an exchange.
+// Each page costs BulkFundamentalsCallCost calls, which are checked against the quota before the request is sent.
+func (b *BulkFundamentalsService) GetBulkFundamentals(exchange string, offset, limit int, symbols []string) ([]*Fundamentals, *Response, error) {
+	params, err := NewBulkFundamentalsParams(b.c.GetApiToken(), exchange, offset, limit, symbols)
+	if err != nil {
+		return nil, nil, err
+	}
+	return b.getPage(params)
+}
+
+func (b *BulkFundamentalsService) getPage(params *BulkFundamentalsParams) ([]*Fundamentals, *Response, error) {
+	u, err := params.BuildPath(b.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := b.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = withCallCost(req, BulkFundamentalsCallCost)
+
+	var data BulkFundamentalsPage
+	res, err := b.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// Iterate returns an iterator over the pages of an exchange, starting at offset.
+func (b *BulkFundamentalsService) Iterate(exchange string, offset, limit int, symbols []string) (*BulkFundamentalsIterator, error) {
+	params, err := NewBulkFundamentalsParams(b.c.GetApiToken(), exchange, offset, limit, symbols)
+	if err != nil {
+		return nil, err
+	}
+	return NewOffsetPager(params.Offset, params.Limit, 0, func(offset, limit int) ([]*Fundamentals, int, *Response, error) {
+		p := *params
+		p.Offset = offset
+		page, res, err := b.getPage(&p)
+		return page, 0, res, err
+	}), nil
+}
+
+// BulkFundamentalsIterator walks the pages of a bulk fundamentals request. Its Offset can be used to resume.
+type BulkFundamentalsIterator = OffsetPager[*Fundamentals]
@@ -0,0 +1,47 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestBulkFundamentalsParams_BuildPath(t *testing.T) {
+	baseURL, _ := url.Parse("https://eodhd.com/api")
+	p, err := NewBulkFundamentalsParams("test-token", "NASDAQ", 500, 100, []string{"AAPL.US", "MSFT.US"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := p.BuildPath(baseURL)
+	if err != nil {
+		t.Error(err)
+	}
+	expected := "https://eodhd.com/api/bulk-fundamentals/NASDAQ?api_token=test-token&fmt=json&limit=100&offset=500&symbols=AAPL.US%2CMSFT.US"
+	if expected != u {
+		t.Errorf("expected %s, got %s", expected, u)
+	}
+
+	if _, err = NewBulkFundamentalsParams("test-token", "NASDAQ", 0, 501, nil); err == nil {
+		t.Error("expected error for limit over 500")
+	}
+}
+
+func TestBulkFundamentalsPage_UnmarshalJSON(t *testing.T) {
+	body := []byte(`{"0":{"General":{"Code":"AAPL"},"Highlights":{"MarketCapitalization":3000000000000}},"1":{"General":{"Code":"MSFT"},"Highlights":{"MarketCapitalization":null}}}`)
+	var page BulkFundamentalsPage
+	if err := json.Unmarshal(body, &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(page))
+	}
+	if page[0].General.Code != "AAPL" || page[1].General.Code != "MSFT" {
+		t.Errorf("unexpected order %s, %s", page[0].General.Code, page[1].General.Code)
+	}
+	if page[1].Highlights.MarketCapitalization != nil {
+		t.Error("expected nil market capitalization")
+	}
+}
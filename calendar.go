@@ -0,0 +1,284 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+const (
+	CalendarEarnings = "earnings"
+	CalendarTrends   = "trends"
+	CalendarIpos     = "ipos"
+	CalendarSplits   = "splits"
+)
+
+const (
+	BeforeMarket = "BeforeMarket"
+	AfterMarket  = "AfterMarket"
+)
+
+// DefaultCalendarWindowDays is the longest date window requested in a single calendar call.
+const DefaultCalendarWindowDays = 30
+
+type CalendarParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Calendar string        `url:"-"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+	Symbols  []string      `url:"symbols,comma,omitempty"`
+}
+
+func NewCalendarParams(apiToken, calendar string, from, to *time.Time, symbols []string) *CalendarParams {
+	return &CalendarParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Calendar: calendar,
+		FromTime: from,
+		ToTime:   to,
+		Symbols:  symbols,
+	}
+}
+
+func (c *CalendarParams) GetEncoded() (string, error) {
+	if c.FromTime != nil {
+		from := c.FromTime.Format(urlDateFormat)
+		c.From = &from
+	}
+	if c.ToTime != nil {
+		to := c.ToTime.Format(urlDateFormat)
+		c.To = &to
+	}
+	q, err := query.Values(c)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (c *CalendarParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("calendar/%s", c.Calendar)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := c.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type EarningsEvent struct {
+	Code              string   `json:"code"`
+	ReportDate        Date     `json:"report_date"`
+	Date              Date     `json:"date"`
+	BeforeAfterMarket string   `json:"before_after_market"`
+	Currency          string   `json:"currency"`
+	Actual            *float64 `json:"actual"`
+	Estimate          *float64 `json:"estimate"`
+	Difference        *float64 `json:"difference"`
+	SurprisePercent   *float64 `json:"percent"`
+}
+
+type EarningsTrend struct {
+	Code                             string   `json:"code"`
+	Date                             Date     `json:"date"`
+	Period                           string   `json:"period"`
+	Growth                           *float64 `json:"growth"`
+	EarningsEstimateAvg              *float64 `json:"earningsEstimateAvg"`
+	EarningsEstimateLow              *float64 `json:"earningsEstimateLow"`
+	EarningsEstimateHigh             *float64 `json:"earningsEstimateHigh"`
+	EarningsEstimateYearAgoEps       *float64 `json:"earningsEstimateYearAgoEps"`
+	EarningsEstimateNumberOfAnalysts *float64 `json:"earningsEstimateNumberOfAnalysts"`
+	EarningsEstimateGrowth           *float64 `json:"earningsEstimateGrowth"`
+	RevenueEstimateAvg               *float64 `json:"revenueEstimateAvg"`
+	RevenueEstimateLow               *float64 `json:"revenueEstimateLow"`
+	RevenueEstimateHigh              *float64 `json:"revenueEstimateHigh"`
+	RevenueEstimateYearAgoEps        *float64 `json:"revenueEstimateYearAgoEps"`
+	RevenueEstimateNumberOfAnalysts  *float64 `json:"revenueEstimateNumberOfAnalysts"`
+	RevenueEstimateGrowth            *float64 `json:"revenueEstimateGrowth"`
+}
+
+// IpoEvent is an IPO. DealType holds the status, e.g. Expected, Priced, Filed or Withdrawn.
+type IpoEvent struct {
+	Code        string   `json:"code"`
+	Name        string   `json:"name"`
+	Exchange    string   `json:"exchange"`
+	Currency    string   `json:"currency"`
+	StartDate   Date     `json:"start_date"`
+	FilingDate  Date     `json:"filing_date"`
+	AmendedDate Date     `json:"amended_date"`
+	PriceFrom   *float64 `json:"price_from"`
+	PriceTo     *float64 `json:"price_to"`
+	OfferPrice  *float64 `json:"offer_price"`
+	Shares      *float64 `json:"shares"`
+	DealType    string   `json:"deal_type"`
+}
+
+type SplitEvent struct {
+	Code       string  `json:"code"`
+	SplitDate  Date    `json:"split_date"`
+	Optionable string  `json:"optionable"`
+	OldShares  float64 `json:"old_shares"`
+	NewShares  float64 `json:"new_shares"`
+}
+
+type earningsCalendar struct {
+	Earnings []*EarningsEvent `json:"earnings"`
+}
+
+type trendsCalendar struct {
+	Trends [][]*EarningsTrend `json:"trends"`
+}
+
+type iposCalendar struct {
+	Ipos []*IpoEvent `json:"ipos"`
+}
+
+type splitsCalendar struct {
+	Splits []*SplitEvent `json:"splits"`
+}
+
+type CalendarService struct {
+	c RequestClient
+	// WindowDays is the longest date window requested in one call.
+	// Longer windows are split across several calls.
+	WindowDays int
+}
+
+func NewCalendarService(c RequestClient) *CalendarService {
+	return &CalendarService{
+		c:          c,
+		WindowDays: DefaultCalendarWindowDays,
+	}
+}
+
+// GetEarnings returns the earnings reports between from and to, optionally limited to symbols.
+// The API ignores from and to when symbols are given, so those reports are filtered by report date locally.
+func (c *CalendarService) GetEarnings(from, to *time.Time, symbols []string) ([]*EarningsEvent, *Response, error) {
+	data := make([]*EarningsEvent, 0)
+	res, err := c.getWindowed(CalendarEarnings, from, to, symbols, func() interface{} {
+		return new(earningsCalendar)
+	}, func(v interface{}) {
+		for _, e := range v.(*earningsCalendar).Earnings {
+			if len(symbols) > 0 && !inDateRange(e.ReportDate.Time, from, to) {
+				continue
+			}
+			data = append(data, e)
+		}
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func inDateRange(date time.Time, from, to *time.Time) bool {
+	if from != nil && date.Before(*from) {
+		return false
+	}
+	return to == nil || !date.After(*to)
+}
+
+// GetEarningsTrends returns the earnings trends of symbols.
+func (c *CalendarService) GetEarningsTrends(symbols []string) ([]*EarningsTrend, *Response, error) {
+	if len(symbols) == 0 {
+		return nil, nil, errors.New("at least one symbol is required")
+	}
+	params := NewCalendarParams(c.c.GetApiToken(), CalendarTrends, nil, nil, symbols)
+
+	var cal trendsCalendar
+	res, err := c.get(params, &cal)
+	if err != nil {
+		return nil, res, err
+	}
+
+	data := make([]*EarningsTrend, 0)
+	for _, t := range cal.Trends {
+		data = append(data, t...)
+	}
+	return data, res, nil
+}
+
+// GetIpos returns the IPOs between from and to.
+func (c *CalendarService) GetIpos(from, to *time.Time) ([]*IpoEvent, *Response, error) {
+	data := make([]*IpoEvent, 0)
+	res, err := c.getWindowed(CalendarIpos, from, to, nil, func() interface{} {
+		return new(iposCalendar)
+	}, func(v interface{}) {
+		data = append(data, v.(*iposCalendar).Ipos...)
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+// GetSplits returns the upcoming splits between from and to.
+func (c *CalendarService) GetSplits(from, to *time.Time) ([]*SplitEvent, *Response, error) {
+	data := make([]*SplitEvent, 0)
+	res, err := c.getWindowed(CalendarSplits, from, to, nil, func() interface{} {
+		return new(splitsCalendar)
+	}, func(v interface{}) {
+		data = append(data, v.(*splitsCalendar).Splits...)
+	})
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+// getWindowed requests the calendar once per window of WindowDays when both from and to are set.
+// Requests for symbols are never split, because the API then returns the full history on every call.
+// The returned Response is the one of the last window.
+func (c *CalendarService) getWindowed(calendar string, from, to *time.Time, symbols []string, newData func() interface{}, collect func(interface{})) (*Response, error) {
+	if from == nil || to == nil || len(symbols) > 0 {
+		params := NewCalendarParams(c.c.GetApiToken(), calendar, from, to, symbols)
+		v := newData()
+		res, err := c.get(params, v)
+		if err != nil {
+			return res, err
+		}
+		collect(v)
+		return res, nil
+	}
+	if to.Before(*from) {
+		return nil, errors.New("to must not be before from")
+	}
+
+	var res *Response
+	for _, w := range splitDateWindow(*from, *to, c.WindowDays) {
+		params := NewCalendarParams(c.c.GetApiToken(), calendar, GetPtrTime(w[0]), GetPtrTime(w[1]), symbols)
+		v := newData()
+		var err error
+		res, err = c.get(params, v)
+		if err != nil {
+			return res, err
+		}
+		collect(v)
+	}
+	return res, nil
+}
+
+func (c *CalendarService) get(params *CalendarParams, data interface{}) (*Response, error) {
+	u, err := params.BuildPath(c.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.c.Do(req, data)
+}
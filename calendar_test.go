@@ -0,0 +1,95 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCalendarParams_BuildPath(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+	p := NewCalendarParams("test-token", CalendarEarnings, &from, &to, []string{"AAPL.US", "MSFT.US"})
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/calendar/earnings?api_token=test-token&fmt=json&from=2024-01-01&symbols=AAPL.US%2CMSFT.US&to=2024-01-31"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestSplitDateWindow(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	windows := splitDateWindow(from, to, 30)
+	if len(windows) != 3 {
+		t.Fatalf("expected 3 windows, got %d", len(windows))
+	}
+	if !windows[0][1].Equal(time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected end of first window %s", windows[0][1])
+	}
+	if !windows[1][0].Equal(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start of second window %s", windows[1][0])
+	}
+	if !windows[2][1].Equal(to) {
+		t.Errorf("expected last window to end at %s, got %s", to, windows[2][1])
+	}
+}
+
+func TestEarningsEvent_Unmarshal(t *testing.T) {
+	body := []byte(`{"earnings":[{"code":"AAPL.US","report_date":"2024-02-01","date":"2023-12-31","before_after_market":"AfterMarket","currency":"USD","actual":2.18,"estimate":2.1,"difference":0.08,"percent":3.8095}]}`)
+	var cal earningsCalendar
+	if err := json.Unmarshal(body, &cal); err != nil {
+		t.Fatal(err)
+	}
+	e := cal.Earnings[0]
+	if e.ReportDate.String() != "2024-02-01" || e.BeforeAfterMarket != AfterMarket {
+		t.Errorf("unexpected event %+v", e)
+	}
+	if e.SurprisePercent == nil || *e.SurprisePercent != 3.8095 {
+		t.Errorf("unexpected surprise %v", e.SurprisePercent)
+	}
+}
+
+func TestCalendarService_GetEarningsSymbols(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"type":"Earnings","earnings":[
+			{"code":"AAPL.US","report_date":"2023-11-02","date":"2023-09-30"},
+			{"code":"AAPL.US","report_date":"2024-02-01","date":"2023-12-31"},
+			{"code":"AAPL.US","report_date":"2024-05-02","date":"2024-03-31"}]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	events, _, err := c.CalendarService.GetEarnings(&from, &to, []string{"AAPL.US"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single call for symbols, got %d", calls)
+	}
+	if len(events) != 2 || events[0].ReportDate.Month() != time.February {
+		t.Errorf("unexpected events %+v", events)
+	}
+}
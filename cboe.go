@@ -0,0 +1,209 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+const (
+	CboeIndicesEndpoint = "cboe/indices"
+	CboeIndexEndpoint   = "cboe/index"
+)
+
+const (
+	CboeFeedOfficialClosing = "snapshot_official_closing"
+	CboeFeedCloseOfDay      = "snapshot_close_of_day"
+)
+
+type CboeParams struct {
+	ApiToken  string        `url:"api_token"`
+	Format    RequestFormat `url:"fmt"`
+	Endpoint  string        `url:"-"`
+	IndexCode *string       `url:"filter[index_code],omitempty"`
+	FeedType  *string       `url:"filter[feed_type],omitempty"`
+	Date      *string       `url:"filter[date],omitempty"`
+}
+
+// NewCboeIndexParams builds the params of an index snapshot. All three filters are required by the API.
+func NewCboeIndexParams(apiToken, indexCode, feedType string, date time.Time) (*CboeParams, error) {
+	if indexCode == "" {
+		return nil, errors.New("index code is required")
+	}
+	if feedType == "" {
+		feedType = CboeFeedOfficialClosing
+	}
+	if date.IsZero() {
+		return nil, errors.New("date is required")
+	}
+	return &CboeParams{
+		ApiToken:  apiToken,
+		Format:    formatJson,
+		Endpoint:  CboeIndexEndpoint,
+		IndexCode: &indexCode,
+		FeedType:  &feedType,
+		Date:      GetPtrString(date.Format(urlDateFormat)),
+	}, nil
+}
+
+func (c *CboeParams) GetEncoded() (string, error) {
+	q, err := query.Values(c)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (c *CboeParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(c.Endpoint)
+	encoded, err := c.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// CboeIndex is the latest close of a CBOE Europe index.
+type CboeIndex struct {
+	ID           string    `json:"-"`
+	Region       string    `json:"region"`
+	IndexCode    string    `json:"index_code"`
+	FeedType     string    `json:"feed_type"`
+	Date         Date      `json:"date"`
+	IndexClose   FlexFloat `json:"index_close"`
+	IndexDivisor FlexFloat `json:"index_divisor"`
+}
+
+func (c *CboeIndex) UnmarshalJSON(b []byte) error {
+	type attributes CboeIndex
+	var raw struct {
+		ID         string     `json:"id"`
+		Attributes attributes `json:"attributes"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	*c = CboeIndex(raw.Attributes)
+	c.ID = raw.ID
+	return nil
+}
+
+// CboeComponent is a constituent of a CBOE index snapshot.
+type CboeComponent struct {
+	Symbol       string    `json:"symbol"`
+	Isin         string    `json:"isin"`
+	Name         string    `json:"name"`
+	Sedol        string    `json:"sedol"`
+	Cusip        string    `json:"cusip"`
+	Sector       string    `json:"sector"`
+	Currency     string    `json:"currency"`
+	ClosingPrice FlexFloat `json:"closing_price"`
+	TotalShares  FlexFloat `json:"total_shares"`
+	MarketCap    FlexFloat `json:"market_cap"`
+	IndexValue   FlexFloat `json:"index_value"`
+	Weighting    FlexFloat `json:"weighting"`
+}
+
+// CboeIndexSnapshot is an index close together with its constituents and their weights.
+type CboeIndexSnapshot struct {
+	CboeIndex
+	Components []*CboeComponent
+}
+
+func (c *CboeIndexSnapshot) UnmarshalJSON(b []byte) error {
+	var index CboeIndex
+	if err := json.Unmarshal(b, &index); err != nil {
+		return err
+	}
+	var raw struct {
+		Components []struct {
+			Attributes *CboeComponent `json:"attributes"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	c.CboeIndex = index
+	c.Components = make([]*CboeComponent, 0, len(raw.Components))
+	for _, comp := range raw.Components {
+		if comp.Attributes != nil {
+			c.Components = append(c.Components, comp.Attributes)
+		}
+	}
+	return nil
+}
+
+type CboeService struct {
+	c RequestClient
+}
+
+func NewCboeService(c RequestClient) *CboeService {
+	return &CboeService{
+		c: c,
+	}
+}
+
+// IterateIndices returns a pager over the list of CBOE Europe indices.
+func (c *CboeService) IterateIndices() (*LinkPager[*CboeIndex], error) {
+	params := &CboeParams{
+		ApiToken: c.c.GetApiToken(),
+		Format:   formatJson,
+		Endpoint: CboeIndicesEndpoint,
+	}
+	u, err := params.BuildPath(c.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+	return NewLinkPager[*CboeIndex](c.c, u)
+}
+
+// GetIndices fetches every page of the list of CBOE Europe indices.
+func (c *CboeService) GetIndices() ([]*CboeIndex, *Response, error) {
+	pager, err := c.IterateIndices()
+	if err != nil {
+		return nil, nil, err
+	}
+	data, err := pager.All()
+	return data, pager.Response(), err
+}
+
+// IterateIndex returns a pager over the snapshots of an index on date.
+func (c *CboeService) IterateIndex(indexCode, feedType string, date time.Time) (*LinkPager[*CboeIndexSnapshot], error) {
+	params, err := NewCboeIndexParams(c.c.GetApiToken(), indexCode, feedType, date)
+	if err != nil {
+		return nil, err
+	}
+	u, err := params.BuildPath(c.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+	return NewLinkPager[*CboeIndexSnapshot](c.c, u)
+}
+
+// GetIndex fetches the snapshot of an index on date with its constituents merged across pages.
+func (c *CboeService) GetIndex(indexCode, feedType string, date time.Time) (*CboeIndexSnapshot, *Response, error) {
+	pager, err := c.IterateIndex(indexCode, feedType, date)
+	if err != nil {
+		return nil, nil, err
+	}
+	snapshots, err := pager.All()
+	if err != nil {
+		return nil, pager.Response(), err
+	}
+	if len(snapshots) == 0 {
+		return nil, pager.Response(), nil
+	}
+	snapshot := snapshots[0]
+	for _, s := range snapshots[1:] {
+		snapshot.Components = append(snapshot.Components, s.Components...)
+	}
+	return snapshot, pager.Response(), nil
+}
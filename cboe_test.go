@@ -0,0 +1,98 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestCboeParams_BuildPath(t *testing.T) {
+	p, err := NewCboeIndexParams("test-token", "BDE30P", "", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/cboe/index?api_token=test-token&filter%5Bdate%5D=2024-01-02" +
+		"&filter%5Bfeed_type%5D=snapshot_official_closing&filter%5Bindex_code%5D=BDE30P&fmt=json"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	if _, err = NewCboeIndexParams("test-token", "", "", time.Now()); err == nil {
+		t.Error("expected error for missing index code")
+	}
+}
+
+func TestCboeService_GetIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("api_token") != "test-token" || r.URL.Query().Get("fmt") != "json" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("page[offset]") == "" {
+			_, _ = w.Write([]byte(`{"data":[{"id":"BDE30P-2024-01-02","type":"cboe-index",
+				"attributes":{"region":"Germany","index_code":"BDE30P","feed_type":"snapshot_official_closing","date":"2024-01-02","index_close":"17550.25","index_divisor":1200.5},
+				"components":[{"attributes":{"symbol":"SAPd","isin":"DE0007164600","name":"SAP SE","closing_price":140.2,"weighting":"0.095"}}]}],
+				"links":{"next":"/api/cboe/index?filter[index_code]=BDE30P&page[offset]=1"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":[{"id":"BDE30P-2024-01-02","type":"cboe-index",
+			"attributes":{"region":"Germany","index_code":"BDE30P"},
+			"components":[{"attributes":{"symbol":"SIEd","name":"Siemens AG","weighting":0.08}}]}],
+			"links":{"next":null}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot, _, err := c.CboeService.GetIndex("BDE30P", "", time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snapshot.ID != "BDE30P-2024-01-02" || snapshot.IndexClose != 17550.25 || snapshot.Region != "Germany" {
+		t.Errorf("unexpected snapshot %+v", snapshot.CboeIndex)
+	}
+	if len(snapshot.Components) != 2 || snapshot.Components[0].Weighting != 0.095 || snapshot.Components[1].Symbol != "SIEd" {
+		t.Errorf("unexpected components %+v", snapshot.Components)
+	}
+}
+
+func TestLinkPager_Loop(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":[{"id":"A"}],"links":{"next":"` + r.URL.String() + `"}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pager, err := NewLinkPager[*CboeIndex](c, server.URL+"/api/cboe/indices?api_token=test-token&fmt=json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, err := pager.All()
+	if err == nil {
+		t.Error("expected error for a next link pointing back to the same page")
+	}
+	if len(items) != 1 {
+		t.Errorf("expected 1 item before the loop was detected, got %d", len(items))
+	}
+}
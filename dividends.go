@@ -0,0 +1,192 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+type DividendsParams struct {
+	Symbol      string        `url:"-"`
+	CountryCode string        `url:"-"`
+	Format      RequestFormat `url:"fmt"`
+	FromTime    *time.Time    `url:"-"`
+	ToTime      *time.Time    `url:"-"`
+	From        *string       `url:"from,omitempty"`
+	To          *string       `url:"to,omitempty"`
+	ApiToken    string        `url:"api_token"`
+}
+
+func (d *DividendsParams) GetEncoded() (string, error) {
+	if d.FromTime != nil {
+		from := d.FromTime.Format(urlDateFormat)
+		d.From = &from
+	}
+	if d.ToTime != nil {
+		to := d.ToTime.Format(urlDateFormat)
+		d.To = &to
+	}
+	q, err := query.Values(d)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (d *DividendsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("div/%s.%s", d.Symbol, d.CountryCode)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := d.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// Dividend is a single dividend payment. Dates the API leaves empty are nil.
+type Dividend struct {
+	Date            time.Time
+	DeclarationDate *time.Time
+	RecordDate      *time.Time
+	PaymentDate     *time.Time
+	Period          string
+	Value           float64
+	UnadjustedValue float64
+	Currency        string
+}
+
+type dividendJson struct {
+	Date            string  `json:"date"`
+	DeclarationDate *string `json:"declarationDate"`
+	RecordDate      *string `json:"recordDate"`
+	PaymentDate     *string `json:"paymentDate"`
+	Period          string  `json:"period"`
+	Value           float64 `json:"value"`
+	UnadjustedValue float64 `json:"unadjustedValue"`
+	Currency        string  `json:"currency"`
+}
+
+func (d *Dividend) UnmarshalJSON(b []byte) error {
+	var raw dividendJson
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	date, err := time.Parse(urlDateFormat, raw.Date)
+	if err != nil {
+		return err
+	}
+	if d.DeclarationDate, err = parseOptionalDate(raw.DeclarationDate); err != nil {
+		return err
+	}
+	if d.RecordDate, err = parseOptionalDate(raw.RecordDate); err != nil {
+		return err
+	}
+	if d.PaymentDate, err = parseOptionalDate(raw.PaymentDate); err != nil {
+		return err
+	}
+
+	d.Date = date
+	d.Period = raw.Period
+	d.Value = raw.Value
+	d.UnadjustedValue = raw.UnadjustedValue
+	d.Currency = raw.Currency
+	return nil
+}
+
+type DividendsService struct {
+	c RequestClient
+}
+
+func NewDividendsService(c RequestClient) *DividendsService {
+	return &DividendsService{
+		c: c,
+	}
+}
+
+func (d *DividendsService) GetDividends(symbol string, countryCode *string, from, to *time.Time) ([]*Dividend, *Response, error) {
+	country := defaultCountryCode
+	if countryCode != nil {
+		country = *countryCode
+	}
+
+	params := &DividendsParams{
+		ApiToken:    d.c.GetApiToken(),
+		Format:      formatJson,
+		Symbol:      symbol,
+		CountryCode: country,
+		FromTime:    from,
+		ToTime:      to,
+	}
+
+	u, err := params.BuildPath(d.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := d.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*Dividend
+	res, err := d.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// TrailingDividendPerShare sums the adjusted dividends with an ex-date in the 12 months up to and including asOf.
+func TrailingDividendPerShare(dividends []*Dividend, asOf time.Time) float64 {
+	start := asOf.AddDate(-1, 0, 0)
+	total := 0.0
+	for _, d := range dividends {
+		if d.Date.After(start) && !d.Date.After(asOf) {
+			total += d.Value
+		}
+	}
+	return total
+}
+
+// DividendYield is the trailing 12 month dividend yield on a single day.
+type DividendYield struct {
+	Date        time.Time
+	Close       float64
+	TTMDividend float64
+	Yield       float64
+}
+
+// DividendYields computes the trailing 12 month dividend yield for each close in prices.
+// The adjusted dividends are scaled back by the splits after each day so that they are in the
+// same share basis as the raw close. Rows with a zero close are skipped.
+func DividendYields(dividends []*Dividend, splits []*Split, prices []*Ohlcv) ([]*DividendYield, error) {
+	yields := make([]*DividendYield, 0, len(prices))
+	for _, p := range prices {
+		if p.DateParsed == nil {
+			if err := p.ParseDate(); err != nil {
+				return nil, err
+			}
+		}
+		if p.Close == 0 {
+			continue
+		}
+		ttm := TrailingDividendPerShare(dividends, *p.DateParsed) * CumulativeSplitFactor(splits, *p.DateParsed)
+		yields = append(yields, &DividendYield{
+			Date:        *p.DateParsed,
+			Close:       p.Close,
+			TTMDividend: ttm,
+			Yield:       ttm / p.Close,
+		})
+	}
+	return yields, nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestDividendsParams_BuildPath(t *testing.T) {
+	p := &DividendsParams{
+		ApiToken:    "test-token",
+		Format:      formatJson,
+		Symbol:      "AAPL",
+		CountryCode: "US",
+		FromTime:    GetPtrTime(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:      GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/div/AAPL.US?api_token=test-token&fmt=json&from=2023-01-01&to=2024-01-01"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestDividendYields(t *testing.T) {
+	body := []byte(`[
+		{"date":"2023-02-10","declarationDate":"2023-02-02","recordDate":"2023-02-13","paymentDate":"2023-02-16","period":"Quarterly","value":0.23,"unadjustedValue":0.23,"currency":"USD"},
+		{"date":"2023-05-12","declarationDate":null,"recordDate":null,"paymentDate":null,"period":"Quarterly","value":0.24,"unadjustedValue":0.24,"currency":"USD"}
+	]`)
+	var divs []*Dividend
+	if err := json.Unmarshal(body, &divs); err != nil {
+		t.Fatal(err)
+	}
+	if divs[1].PaymentDate != nil {
+		t.Error("expected nil payment date")
+	}
+	if divs[0].PaymentDate == nil || divs[0].PaymentDate.Day() != 16 {
+		t.Errorf("unexpected payment date %v", divs[0].PaymentDate)
+	}
+
+	prices := []*Ohlcv{{Date: "2023-06-01", Close: 47}, {Date: "2024-03-01", Close: 24}}
+	yields, err := DividendYields(divs, nil, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(yields[0].Yield-0.01) > 1e-9 {
+		t.Errorf("expected yield 0.01, got %f", yields[0].Yield)
+	}
+	if math.Abs(yields[1].TTMDividend-0.24) > 1e-9 {
+		t.Errorf("expected ttm dividend 0.24, got %f", yields[1].TTMDividend)
+	}
+}
+
+func TestDividendYields_Split(t *testing.T) {
+	// AAPL paid 0.82 a share before its 4:1 split on 2020-08-31, adjusted to 0.205
+	body := []byte(`[
+		{"date":"2019-11-07","period":"Quarterly","value":0.1925,"unadjustedValue":0.77,"currency":"USD"},
+		{"date":"2020-08-07","period":"Quarterly","value":0.205,"unadjustedValue":0.82,"currency":"USD"},
+		{"date":"2020-11-06","period":"Quarterly","value":0.205,"unadjustedValue":0.205,"currency":"USD"}
+	]`)
+	var divs []*Dividend
+	if err := json.Unmarshal(body, &divs); err != nil {
+		t.Fatal(err)
+	}
+	splits := []*Split{{Date: time.Date(2020, 8, 31, 0, 0, 0, 0, time.UTC), Numerator: 4, Denominator: 1, Factor: 4}}
+
+	prices := []*Ohlcv{{Date: "2020-08-10", Close: 450}, {Date: "2020-09-01", Close: 134}, {Date: "2020-11-09", Close: 116}}
+	yields, err := DividendYields(divs, splits, prices)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []float64{(0.77 + 0.82) / 450, (0.1925 + 0.205) / 134, (0.205 + 0.205) / 116}
+	for i, e := range expected {
+		if math.Abs(yields[i].Yield-e) > 1e-9 {
+			t.Errorf("day %d: expected yield %f, got %f", i, e, yields[i].Yield)
+		}
+	}
+}
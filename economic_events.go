@@ -0,0 +1,232 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"math"
+	"net/url"
+	"time"
+)
+
+const EconomicEventsMaxLimit = 1000
+
+const (
+	ComparisonMoM = "mom"
+	ComparisonQoQ = "qoq"
+	ComparisonYoY = "yoy"
+)
+
+const economicEventTimeFormat = "2006-01-02 15:04:05"
+
+type EconomicEventsParams struct {
+	ApiToken   string        `url:"api_token"`
+	Format     RequestFormat `url:"fmt"`
+	Country    *string       `url:"country,omitempty"`
+	Comparison *string       `url:"comparison,omitempty"`
+	Type       *string       `url:"type,omitempty"`
+	FromTime   *time.Time    `url:"-"`
+	ToTime     *time.Time    `url:"-"`
+	From       *string       `url:"from,omitempty"`
+	To         *string       `url:"to,omitempty"`
+	Offset     int           `url:"offset"`
+	Limit      int           `url:"limit"`
+}
+
+// EconomicEventsOptions holds the filters of an economic events request.
+// Country is an ISO 3166 alpha-2 code and Type a release name such as "Consumer Price Index".
+type EconomicEventsOptions struct {
+	Country    *string
+	Comparison *string
+	Type       *string
+	From       *time.Time
+	To         *time.Time
+}
+
+func NewEconomicEventsParams(apiToken string, opts *EconomicEventsOptions, offset, limit int) (*EconomicEventsParams, error) {
+	if limit <= 0 {
+		limit = EconomicEventsMaxLimit
+	}
+	if limit > EconomicEventsMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", EconomicEventsMaxLimit)
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	p := &EconomicEventsParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Offset:   offset,
+		Limit:    limit,
+	}
+	if opts != nil {
+		if opts.Comparison != nil {
+			switch *opts.Comparison {
+			case ComparisonMoM, ComparisonQoQ, ComparisonYoY:
+			default:
+				return nil, fmt.Errorf("invalid comparison %q", *opts.Comparison)
+			}
+		}
+		p.Country = opts.Country
+		p.Comparison = opts.Comparison
+		p.Type = opts.Type
+		p.FromTime = opts.From
+		p.ToTime = opts.To
+	}
+	return p, nil
+}
+
+func (e *EconomicEventsParams) GetEncoded() (string, error) {
+	if e.FromTime != nil {
+		from := e.FromTime.Format(urlDateFormat)
+		e.From = &from
+	}
+	if e.ToTime != nil {
+		to := e.ToTime.Format(urlDateFormat)
+		e.To = &to
+	}
+	q, err := query.Values(e)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (e *EconomicEventsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("economic-events")
+	encoded, err := e.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// EconomicEvent is a macro release. Surprise and SurprisePercent are computed from Actual and Estimate
+// and are nil until both are known.
+type EconomicEvent struct {
+	Type             string
+	Comparison       string
+	Period           string
+	Country          string
+	Date             time.Time
+	Actual           *float64
+	Previous         *float64
+	Estimate         *float64
+	Change           *float64
+	ChangePercentage *float64
+	Surprise         *float64
+	SurprisePercent  *float64
+}
+
+type economicEventJson struct {
+	Type             string   `json:"type"`
+	Comparison       string   `json:"comparison"`
+	Period           string   `json:"period"`
+	Country          string   `json:"country"`
+	Date             string   `json:"date"`
+	Actual           *float64 `json:"actual"`
+	Previous         *float64 `json:"previous"`
+	Estimate         *float64 `json:"estimate"`
+	Change           *float64 `json:"change"`
+	ChangePercentage *float64 `json:"change_percentage"`
+}
+
+func (e *EconomicEvent) UnmarshalJSON(b []byte) error {
+	var raw economicEventJson
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	date, err := time.Parse(economicEventTimeFormat, raw.Date)
+	if err != nil {
+		return err
+	}
+
+	e.Type = raw.Type
+	e.Comparison = raw.Comparison
+	e.Period = raw.Period
+	e.Country = raw.Country
+	e.Date = date
+	e.Actual = raw.Actual
+	e.Previous = raw.Previous
+	e.Estimate = raw.Estimate
+	e.Change = raw.Change
+	e.ChangePercentage = raw.ChangePercentage
+
+	if e.Actual != nil && e.Estimate != nil {
+		surprise := *e.Actual - *e.Estimate
+		e.Surprise = &surprise
+		if *e.Estimate != 0 {
+			pct := surprise / math.Abs(*e.Estimate) * 100
+			e.SurprisePercent = &pct
+		}
+	}
+	return nil
+}
+
+// IsUpcoming reports whether the event has not been released yet.
+func (e *EconomicEvent) IsUpcoming() bool {
+	return e.Actual == nil
+}
+
+type EconomicEventsService struct {
+	c RequestClient
+}
+
+func NewEconomicEventsService(c RequestClient) *EconomicEventsService {
+	return &EconomicEventsService{
+		c: c,
+	}
+}
+
+// GetEconomicEvents fetches a single page of events.
+func (e *EconomicEventsService) GetEconomicEvents(opts *EconomicEventsOptions, offset, limit int) ([]*EconomicEvent, *Response, error) {
+	params, err := NewEconomicEventsParams(e.c.GetApiToken(), opts, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return e.getPage(params)
+}
+
+func (e *EconomicEventsService) getPage(params *EconomicEventsParams) ([]*EconomicEvent, *Response, error) {
+	u, err := params.BuildPath(e.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := e.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*EconomicEvent
+	res, err := e.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// Iterate returns an iterator over all pages of events matching opts.
+func (e *EconomicEventsService) Iterate(opts *EconomicEventsOptions, limit int) (*EconomicEventsIterator, error) {
+	params, err := NewEconomicEventsParams(e.c.GetApiToken(), opts, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	return NewOffsetPager(params.Offset, params.Limit, 0, func(offset, limit int) ([]*EconomicEvent, int, *Response, error) {
+		p := *params
+		p.Offset = offset
+		page, res, err := e.getPage(&p)
+		return page, 0, res, err
+	}), nil
+}
+
+// EconomicEventsIterator walks the pages of an economic events request.
+type EconomicEventsIterator = OffsetPager[*EconomicEvent]
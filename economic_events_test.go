@@ -0,0 +1,52 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestEconomicEventsParams_BuildPath(t *testing.T) {
+	p, err := NewEconomicEventsParams("test-token", &EconomicEventsOptions{
+		Country:    GetPtrString("US"),
+		Comparison: GetPtrString(ComparisonYoY),
+		From:       GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/economic-events?api_token=test-token&comparison=yoy&country=US&fmt=json&from=2024-01-01&limit=100&offset=0"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestEconomicEvent_Surprise(t *testing.T) {
+	body := []byte(`[
+		{"type":"CPI","comparison":"yoy","period":"Feb","country":"US","date":"2024-03-12 12:30:00","actual":3.2,"previous":3.1,"estimate":3.1,"change":0.1,"change_percentage":3.226},
+		{"type":"CPI","comparison":"yoy","period":"Mar","country":"US","date":"2024-04-10 12:30:00","actual":null,"previous":3.2,"estimate":3.4}
+	]`)
+	var events []*EconomicEvent
+	if err := json.Unmarshal(body, &events); err != nil {
+		t.Fatal(err)
+	}
+	if events[0].Surprise == nil || math.Abs(*events[0].Surprise-0.1) > 1e-9 {
+		t.Errorf("unexpected surprise %v", events[0].Surprise)
+	}
+	if events[0].Date.Hour() != 12 {
+		t.Errorf("unexpected date %s", events[0].Date)
+	}
+	if !events[1].IsUpcoming() || events[1].Surprise != nil {
+		t.Error("expected upcoming event without surprise")
+	}
+}
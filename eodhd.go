@@ -6,11 +6,14 @@ package eodhd
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/gocarina/gocsv"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/go-retryablehttp"
 	"golang.org/x/time/rate"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -64,15 +67,46 @@ type Client struct {
 	UserAgent     string
 
 	limiter           *rate.Limiter
+	limiterMu         sync.Mutex
 	maxPercentOfLimit float64
 	limiterBurst      float64
-	configureOnce     sync.Once
+	quota             *Quota
+
+	syncQuota         bool
+	quotaSyncInterval time.Duration
+	quotaSyncStop     chan struct{}
+	quotaSyncMu       sync.Mutex
+	quotaSyncErr      error
+	closeOnce         sync.Once
 
 	// services
 	OhlcvService     *OhlcvService
 	ExchangesService *ExchangesService
 	TickerService    *TickerService
 	BulkEodService   *BulkEodService
+
+	BulkFundamentalsService    *BulkFundamentalsService
+	DividendsService           *DividendsService
+	SplitsService              *SplitsService
+	CalendarService            *CalendarService
+	SearchService              *SearchService
+	TechnicalService           *TechnicalService
+	ScreenerService            *ScreenerService
+	NewsService                *NewsService
+	SentimentService           *SentimentService
+	SymbolChangeService        *SymbolChangeService
+	InsiderTransactionsService *InsiderTransactionsService
+	MacroService               *MacroService
+	EconomicEventsService      *EconomicEventsService
+	MarketCapService           *MarketCapService
+	TreasuryService            *TreasuryService
+	BondService                *BondService
+	OptionsService             *OptionsService
+	AccountService             *AccountService
+	IdMappingService           *IdMappingService
+	LogoService                *LogoService
+	IndexService               *IndexService
+	CboeService                *CboeService
 }
 
 func NewClient(token string, options ...ClientOption) (*Client, error) {
@@ -83,6 +117,7 @@ func NewClient(token string, options ...ClientOption) (*Client, error) {
 		UserAgent:         userAgent,
 		maxPercentOfLimit: DefaultRateLimitPercent,
 		limiterBurst:      DefaultBurstPercent,
+		quota:             NewQuota(0),
 	}
 	err := client.setBaseUrl(defaultBaseUrl)
 	if err != nil {
@@ -118,12 +153,42 @@ func NewClient(token string, options ...ClientOption) (*Client, error) {
 	client.ExchangesService = NewExchangesService(client)
 	client.TickerService = NewTickerService(client)
 	client.BulkEodService = NewBulkEodService(client)
+	client.BulkFundamentalsService = NewBulkFundamentalsService(client)
+	client.DividendsService = NewDividendsService(client)
+	client.SplitsService = NewSplitsService(client)
+	client.CalendarService = NewCalendarService(client)
+	client.SearchService = NewSearchService(client)
+	client.TechnicalService = NewTechnicalService(client)
+	client.ScreenerService = NewScreenerService(client)
+	client.NewsService = NewNewsService(client)
+	client.SentimentService = NewSentimentService(client)
+	client.SymbolChangeService = NewSymbolChangeService(client)
+	client.InsiderTransactionsService = NewInsiderTransactionsService(client)
+	client.MacroService = NewMacroService(client)
+	client.EconomicEventsService = NewEconomicEventsService(client)
+	client.MarketCapService = NewMarketCapService(client)
+	client.TreasuryService = NewTreasuryService(client)
+	client.BondService = NewBondService(client)
+	client.OptionsService = NewOptionsService(client)
+	client.AccountService = NewAccountService(client)
+	client.IdMappingService = NewIdMappingService(client)
+	client.LogoService = NewLogoService(client)
+	client.IndexService = NewIndexService(client)
+	client.CboeService = NewCboeService(client)
 
 	err = client.applyOptions(options...)
 	if err != nil {
 		return nil, err
 	}
 
+	if client.syncQuota {
+		// a failed sync is recorded in QuotaSyncErr and leaves the local accounting in place
+		_ = client.SyncQuota()
+		if client.quotaSyncInterval > 0 {
+			client.startQuotaSync(client.quotaSyncInterval)
+		}
+	}
+
 	return client, nil
 }
 
@@ -152,6 +217,11 @@ func (c *Client) GetBaseUrl() *url.URL {
 	return &u
 }
 
+// GetQuota returns the local accounting of the daily API calls. Every request sent through Do is counted.
+func (c *Client) GetQuota() *Quota {
+	return c.quota
+}
+
 func (c *Client) GetDefaultFormat() RequestFormat {
 	return c.defaultFormat
 }
@@ -205,10 +275,24 @@ func (c *Client) configureRateLimiter(ctx context.Context, limitAmt int) {
 		burst = int(rl * c.limiterBurst)
 	}
 
-	c.limiter = rate.NewLimiter(limit, burst)
+	limiter := rate.NewLimiter(limit, burst)
+	c.limiterMu.Lock()
+	if c.limiter != nil {
+		c.limiterMu.Unlock()
+		return
+	}
+	c.limiter = limiter
+	c.limiterMu.Unlock()
 
 	// wait since we get the limit from the http headers of a response
-	_ = c.limiter.Wait(ctx)
+	_ = limiter.Wait(ctx)
+}
+
+// getLimiter returns the rate limiter, or nil until a response has reported the rate limit.
+func (c *Client) getLimiter() *rate.Limiter {
+	c.limiterMu.Lock()
+	defer c.limiterMu.Unlock()
+	return c.limiter
 }
 
 type Response struct {
@@ -239,8 +323,22 @@ func (r *Response) SetHeaderValues() {
 }
 
 func (c *Client) Do(req *retryablehttp.Request, data interface{}) (*Response, error) {
+	// The cost is reserved up front so that concurrent requests cannot overshoot the quota together
+	cost := callCost(req.Context())
+	if err := c.quota.Reserve(cost); err != nil {
+		return nil, err
+	}
+
+	if limiter := c.getLimiter(); limiter != nil {
+		if err := limiter.Wait(req.Context()); err != nil {
+			c.quota.Refund(cost)
+			return nil, err
+		}
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
+		c.quota.Refund(cost)
 		return nil, err
 	}
 
@@ -251,9 +349,37 @@ func (c *Client) Do(req *retryablehttp.Request, data interface{}) (*Response, er
 
 	response := newResponse(resp)
 
-	// Configure the limiter on the first request made
-	// Only do this once.
-	c.configureOnce.Do(func() { c.configureRateLimiter(req.Context(), response.RateLimit) })
+	// Rejected requests are not counted by the API
+	if resp.StatusCode >= http.StatusBadRequest {
+		c.quota.Refund(cost)
+	}
+
+	// Configure the limiter on the first response that reports the rate limit
+	if response.RateLimit > 0 && c.getLimiter() == nil {
+		c.configureRateLimiter(req.Context(), response.RateLimit)
+	}
+
+	// Raw binary responses are passed through without decoding
+	switch v := data.(type) {
+	case *[]byte:
+		if err = checkBinaryResponse(response); err != nil {
+			return response, err
+		}
+		*v, err = io.ReadAll(resp.Body)
+		if err != nil {
+			return response, err
+		}
+		return response, nil
+	case io.Writer:
+		if err = checkBinaryResponse(response); err != nil {
+			return response, err
+		}
+		_, err = io.Copy(v, resp.Body)
+		if err != nil {
+			return response, err
+		}
+		return response, nil
+	}
 
 	reqFormat := req.URL.Query().Get("fmt")
 	format := formatCSV
@@ -280,3 +406,29 @@ func (c *Client) Do(req *retryablehttp.Request, data interface{}) (*Response, er
 
 	return response, err
 }
+
+// ErrUnexpectedContentType is returned when a binary response carries a JSON or text body,
+// which is how the API reports errors.
+var ErrUnexpectedContentType = errors.New("eodhd: unexpected content type")
+
+// checkBinaryResponse rejects error responses before their body is handed out as binary data.
+// When the request sent a single Accept type, the response must be of that type, or unlabelled.
+func checkBinaryResponse(r *Response) error {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "application/json" || strings.HasPrefix(mediaType, "text/") {
+		return fmt.Errorf("%w %q with status %d", ErrUnexpectedContentType, mediaType, r.StatusCode)
+	}
+	if r.StatusCode >= 400 {
+		return fmt.Errorf("eodhd: request failed with status %d", r.StatusCode)
+	}
+	if r.Request == nil {
+		return nil
+	}
+	accept, _, _ := mime.ParseMediaType(r.Request.Header.Get("Accept"))
+	// octet-stream is accepted since some mirrors do not label binary files
+	if accept != "" && !strings.Contains(accept, "*") && mediaType != "" &&
+		mediaType != accept && mediaType != "application/octet-stream" {
+		return fmt.Errorf("%w %q, expected %s", ErrUnexpectedContentType, mediaType, accept)
+	}
+	return nil
+}
@@ -3,7 +3,10 @@
 
 package eodhd
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 type ClientOption func(*Client) error
 
@@ -18,3 +21,28 @@ func SetPercentOfRateLimit(maxPercent float64) ClientOption {
 		return nil
 	}
 }
+
+// SetDailyQuota sets the number of API calls the subscription allows per day.
+func SetDailyQuota(dailyLimit int) ClientOption {
+	return func(c *Client) error {
+		if dailyLimit < 0 {
+			return errors.New("daily quota must not be negative")
+		}
+		c.quota.Set(dailyLimit, c.quota.Used())
+		return nil
+	}
+}
+
+// SetQuotaSync refreshes the quota from the user endpoint when the client is created,
+// and then every interval if it is positive. Call Client.Close to stop the refresh.
+// A failed refresh, including the first one, does not fail the client and is reported by Client.QuotaSyncErr.
+func SetQuotaSync(interval time.Duration) ClientOption {
+	return func(c *Client) error {
+		if interval < 0 {
+			return errors.New("quota sync interval must not be negative")
+		}
+		c.syncQuota = true
+		c.quotaSyncInterval = interval
+		return nil
+	}
+}
@@ -5,6 +5,8 @@ package eodhd
 
 import (
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -24,3 +26,44 @@ func TestClient_ApplyOptions(t *testing.T) {
 		t.Errorf("expected limiter burst to be 0.10, got %f", burstRounded)
 	}
 }
+
+func TestClient_DoWaitsOnLimiter(t *testing.T) {
+	limit := ""
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if limit != "" {
+			w.Header().Set(RateLimitHeader, limit)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = c.SplitsService.GetSplits("AAPL", nil, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if c.getLimiter() != nil {
+		t.Error("expected no limiter without a rate limit header")
+	}
+
+	limit = "60000"
+	for i := 0; i < 3; i++ {
+		if _, _, err = c.SplitsService.GetSplits("AAPL", nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	limiter := c.getLimiter()
+	if limiter == nil {
+		t.Fatal("expected a limiter once the rate limit is reported")
+	}
+	if math.Abs(float64(limiter.Limit())-1000*c.maxPercentOfLimit) > 1e-9 {
+		t.Errorf("unexpected limit %f", limiter.Limit())
+	}
+}
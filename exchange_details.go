@@ -0,0 +1,232 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	MarketOpen       = "open"
+	MarketClosed     = "closed"
+	MarketEarlyClose = "early_close"
+)
+
+const (
+	// HolidayOfficial closes the exchange. Other types, such as bank holidays, do not.
+	HolidayOfficial = "official"
+	HolidayBank     = "bank"
+)
+
+const tradingHoursFormat = "15:04:05"
+
+type ExchangeDetailsParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Code     string        `url:"-"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+}
+
+func (e *ExchangeDetailsParams) GetEncoded() (string, error) {
+	if e.FromTime != nil {
+		from := e.FromTime.Format(urlDateFormat)
+		e.From = &from
+	}
+	if e.ToTime != nil {
+		to := e.ToTime.Format(urlDateFormat)
+		e.To = &to
+	}
+	q, err := query.Values(e)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (e *ExchangeDetailsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("exchange-details/%s", e.Code)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := e.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type TradingHours struct {
+	Open        string `json:"Open"`
+	Close       string `json:"Close"`
+	OpenUTC     string `json:"OpenUTC"`
+	CloseUTC    string `json:"CloseUTC"`
+	WorkingDays string `json:"WorkingDays"`
+}
+
+// IsWorkingDay reports whether the weekday is listed in WorkingDays, e.g. "Mon,Tue,Wed,Thu,Fri".
+func (t *TradingHours) IsWorkingDay(day time.Weekday) bool {
+	abbr := day.String()[:3]
+	for _, d := range strings.Split(t.WorkingDays, ",") {
+		if strings.EqualFold(strings.TrimSpace(d), abbr) {
+			return true
+		}
+	}
+	return false
+}
+
+type ExchangeHoliday struct {
+	Holiday string `json:"Holiday"`
+	Date    Date   `json:"Date"`
+	Type    string `json:"Type"`
+}
+
+// ClosesExchange reports whether the exchange is closed for the holiday.
+func (h *ExchangeHoliday) ClosesExchange() bool {
+	return strings.EqualFold(h.Type, HolidayOfficial)
+}
+
+type ExchangeHolidays []*ExchangeHoliday
+
+func (h *ExchangeHolidays) UnmarshalJSON(b []byte) error {
+	holidays := make(ExchangeHolidays, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		holiday := new(ExchangeHoliday)
+		if err := dec.Decode(holiday); err != nil {
+			return err
+		}
+		holidays = append(holidays, holiday)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*h = holidays
+	return nil
+}
+
+type ExchangeDetails struct {
+	Name             string           `json:"Name"`
+	Code             string           `json:"Code"`
+	OperatingMIC     string           `json:"OperatingMIC"`
+	Country          string           `json:"Country"`
+	Currency         string           `json:"Currency"`
+	Timezone         string           `json:"Timezone"`
+	IsOpen           bool             `json:"isOpen"`
+	TradingHours     TradingHours     `json:"TradingHours"`
+	ActiveTickers    int              `json:"ActiveTickers"`
+	UpdatedTickers   int              `json:"UpdatedTickers"`
+	ExchangeHolidays ExchangeHolidays `json:"ExchangeHolidays"`
+	// EarlyCloses maps local dates (YYYY-MM-DD) to the close time (HH:MM:SS) of shortened sessions.
+	// The API does not publish them, so they have to be filled from the calendar of the exchange.
+	EarlyCloses map[string]string `json:"-"`
+}
+
+// MarketState is the offline status of an exchange at a point in time.
+type MarketState struct {
+	Status  string
+	Holiday *ExchangeHoliday
+	// Open and Close are the session bounds of the local day, zero when there is no session.
+	Open  time.Time
+	Close time.Time
+}
+
+// MarketStatus works out whether the exchange is open at now from its trading hours, timezone and holidays.
+// Only official holidays close the exchange. During a session shortened by EarlyCloses the status is
+// MarketEarlyClose, outside of it MarketClosed.
+func (e *ExchangeDetails) MarketStatus(now time.Time) (*MarketState, error) {
+	if e.Timezone == "" {
+		return nil, errors.New("exchange timezone is unknown")
+	}
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	local := now.In(loc)
+	day := local.Format(urlDateFormat)
+
+	state := &MarketState{Status: MarketClosed}
+	if !e.TradingHours.IsWorkingDay(local.Weekday()) {
+		return state, nil
+	}
+
+	for _, h := range e.ExchangeHolidays {
+		if h.Date.String() != day {
+			continue
+		}
+		state.Holiday = h
+		if h.ClosesExchange() {
+			return state, nil
+		}
+	}
+
+	closeTime := e.TradingHours.Close
+	earlyClose, early := e.EarlyCloses[day]
+	if early {
+		closeTime = earlyClose
+	}
+
+	open, err := sessionTime(local, e.TradingHours.Open, loc)
+	if err != nil {
+		return nil, err
+	}
+	closing, err := sessionTime(local, closeTime, loc)
+	if err != nil {
+		return nil, err
+	}
+	state.Open = open
+	state.Close = closing
+
+	if !local.Before(open) && local.Before(closing) {
+		state.Status = MarketOpen
+		if early {
+			state.Status = MarketEarlyClose
+		}
+	}
+	return state, nil
+}
+
+func sessionTime(day time.Time, clock string, loc *time.Location) (time.Time, error) {
+	t, err := time.Parse(tradingHoursFormat, clock)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid trading hours %q: %w", clock, err)
+	}
+	return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc), nil
+}
+
+// GetExchangeDetails returns the details of an exchange, including the holidays between from and to.
+func (e *ExchangesService) GetExchangeDetails(code string, from, to *time.Time) (*ExchangeDetails, *Response, error) {
+	params := &ExchangeDetailsParams{
+		ApiToken: e.c.GetApiToken(),
+		Format:   formatJson,
+		Code:     code,
+		FromTime: from,
+		ToTime:   to,
+	}
+	u, err := params.BuildPath(e.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := e.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := new(ExchangeDetails)
+	res, err := e.c.Do(req, data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestExchangeDetailsParams_BuildPath(t *testing.T) {
+	p := &ExchangeDetailsParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		Code:     "US",
+		FromTime: GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/exchange-details/US?api_token=test-token&fmt=json&from=2024-01-01"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestExchangeDetails_MarketStatus(t *testing.T) {
+	body := []byte(`{
+		"Name":"USA Stocks","Code":"US","Timezone":"America/New_York","isOpen":false,
+		"TradingHours":{"Open":"09:30:00","Close":"16:00:00","OpenUTC":"14:30:00","CloseUTC":"21:00:00","WorkingDays":"Mon,Tue,Wed,Thu,Fri"},
+		"ExchangeHolidays":{
+			"0":{"Holiday":"Christmas","Date":"2024-12-25","Type":"official"},
+			"1":{"Holiday":"Columbus Day","Date":"2024-10-14","Type":"bank"}
+		}
+	}`)
+	var details ExchangeDetails
+	if err := json.Unmarshal(body, &details); err != nil {
+		t.Fatal(err)
+	}
+	if len(details.ExchangeHolidays) != 2 {
+		t.Fatalf("expected 2 holidays, got %d", len(details.ExchangeHolidays))
+	}
+	details.EarlyCloses = map[string]string{"2024-12-24": "13:00:00"}
+
+	ny, _ := time.LoadLocation("America/New_York")
+	cases := []struct {
+		now      time.Time
+		expected string
+	}{
+		{time.Date(2024, 12, 23, 10, 0, 0, 0, ny), MarketOpen},
+		{time.Date(2024, 12, 23, 16, 0, 0, 0, ny), MarketClosed},
+		{time.Date(2024, 12, 24, 12, 0, 0, 0, ny), MarketEarlyClose},
+		{time.Date(2024, 12, 24, 14, 0, 0, 0, ny), MarketClosed},
+		{time.Date(2024, 12, 25, 10, 0, 0, 0, ny), MarketClosed},
+		{time.Date(2024, 12, 28, 10, 0, 0, 0, ny), MarketClosed},
+		{time.Date(2024, 12, 23, 15, 0, 0, 0, time.UTC), MarketOpen},
+		{time.Date(2024, 10, 14, 10, 0, 0, 0, ny), MarketOpen},
+	}
+	for _, c := range cases {
+		state, err := details.MarketStatus(c.now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if state.Status != c.expected {
+			t.Errorf("%s: expected %s, got %s", c.now, c.expected, state.Status)
+		}
+	}
+}
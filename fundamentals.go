@@ -0,0 +1,99 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import "encoding/json"
+
+// FundamentalsCallCost is the number of API calls a fundamentals request is billed as.
+const FundamentalsCallCost = 10
+
+// Fundamentals represents the fundamental data of a single symbol.
+// Statement heavy sections are kept raw so callers can decode only what they need.
+type Fundamentals struct {
+	General         FundamentalsGeneral         `json:"General"`
+	Highlights      FundamentalsHighlights      `json:"Highlights"`
+	Valuation       FundamentalsValuation       `json:"Valuation"`
+	SharesStats     FundamentalsSharesStats     `json:"SharesStats"`
+	Technicals      FundamentalsTechnicals      `json:"Technicals"`
+	SplitsDividends FundamentalsSplitsDividends `json:"SplitsDividends"`
+	Earnings        json.RawMessage             `json:"Earnings,omitempty"`
+	Financials      json.RawMessage             `json:"Financials,omitempty"`
+}
+
+type FundamentalsGeneral struct {
+	Code           string `json:"Code"`
+	Type           string `json:"Type"`
+	Name           string `json:"Name"`
+	Exchange       string `json:"Exchange"`
+	CurrencyCode   string `json:"CurrencyCode"`
+	CurrencyName   string `json:"CurrencyName"`
+	CurrencySymbol string `json:"CurrencySymbol"`
+	CountryName    string `json:"CountryName"`
+	CountryISO     string `json:"CountryISO"`
+	ISIN           string `json:"ISIN"`
+	CUSIP          string `json:"CUSIP"`
+	CIK            string `json:"CIK"`
+	PrimaryTicker  string `json:"PrimaryTicker"`
+	Sector         string `json:"Sector"`
+	Industry       string `json:"Industry"`
+	Description    string `json:"Description"`
+	IPODate        string `json:"IPODate"`
+	IsDelisted     bool   `json:"IsDelisted"`
+}
+
+type FundamentalsHighlights struct {
+	MarketCapitalization  *float64 `json:"MarketCapitalization"`
+	EBITDA                *float64 `json:"EBITDA"`
+	PERatio               *float64 `json:"PERatio"`
+	PEGRatio              *float64 `json:"PEGRatio"`
+	WallStreetTargetPrice *float64 `json:"WallStreetTargetPrice"`
+	BookValue             *float64 `json:"BookValue"`
+	DividendShare         *float64 `json:"DividendShare"`
+	DividendYield         *float64 `json:"DividendYield"`
+	EarningsShare         *float64 `json:"EarningsShare"`
+	ProfitMargin          *float64 `json:"ProfitMargin"`
+	OperatingMarginTTM    *float64 `json:"OperatingMarginTTM"`
+	ReturnOnAssetsTTM     *float64 `json:"ReturnOnAssetsTTM"`
+	ReturnOnEquityTTM     *float64 `json:"ReturnOnEquityTTM"`
+	RevenueTTM            *float64 `json:"RevenueTTM"`
+	RevenuePerShareTTM    *float64 `json:"RevenuePerShareTTM"`
+}
+
+type FundamentalsValuation struct {
+	TrailingPE             *float64 `json:"TrailingPE"`
+	ForwardPE              *float64 `json:"ForwardPE"`
+	PriceSalesTTM          *float64 `json:"PriceSalesTTM"`
+	PriceBookMRQ           *float64 `json:"PriceBookMRQ"`
+	EnterpriseValue        *float64 `json:"EnterpriseValue"`
+	EnterpriseValueRevenue *float64 `json:"EnterpriseValueRevenue"`
+	EnterpriseValueEbitda  *float64 `json:"EnterpriseValueEbitda"`
+}
+
+type FundamentalsSharesStats struct {
+	SharesOutstanding   *float64 `json:"SharesOutstanding"`
+	SharesFloat         *float64 `json:"SharesFloat"`
+	PercentInsiders     *float64 `json:"PercentInsiders"`
+	PercentInstitutions *float64 `json:"PercentInstitutions"`
+}
+
+type FundamentalsTechnicals struct {
+	Beta         *float64 `json:"Beta"`
+	High52Week   *float64 `json:"52WeekHigh"`
+	Low52Week    *float64 `json:"52WeekLow"`
+	MA50Day      *float64 `json:"50DayMA"`
+	MA200Day     *float64 `json:"200DayMA"`
+	SharesShort  *float64 `json:"SharesShort"`
+	ShortRatio   *float64 `json:"ShortRatio"`
+	ShortPercent *float64 `json:"ShortPercent"`
+}
+
+type FundamentalsSplitsDividends struct {
+	ForwardAnnualDividendRate  *float64 `json:"ForwardAnnualDividendRate"`
+	ForwardAnnualDividendYield *float64 `json:"ForwardAnnualDividendYield"`
+	PayoutRatio                *float64 `json:"PayoutRatio"`
+	DividendDate               string   `json:"DividendDate"`
+	ExDividendDate             string   `json:"ExDividendDate"`
+	LastSplitFactor            string   `json:"LastSplitFactor"`
+	LastSplitDate              string   `json:"LastSplitDate"`
+}
@@ -0,0 +1,419 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const IdMappingMaxLimit = 1000
+
+type IdType string
+
+const (
+	IdIsin  IdType = "isin"
+	IdCusip IdType = "cusip"
+	IdFigi  IdType = "figi"
+	IdLei   IdType = "lei"
+	IdCik   IdType = "cik"
+)
+
+// IdMappingFilter selects the mappings to return. All fields are optional.
+type IdMappingFilter struct {
+	Symbol   *string
+	Exchange *string
+	Isin     *string
+	Figi     *string
+	Lei      *string
+	Cik      *string
+	Cusip    *string
+}
+
+type IdMappingParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Symbol   *string       `url:"filter[symbol],omitempty"`
+	Exchange *string       `url:"filter[ex],omitempty"`
+	Isin     *string       `url:"filter[isin],omitempty"`
+	Figi     *string       `url:"filter[figi],omitempty"`
+	Lei      *string       `url:"filter[lei],omitempty"`
+	Cik      *string       `url:"filter[cik],omitempty"`
+	Cusip    *string       `url:"filter[cusip],omitempty"`
+	Offset   int           `url:"page[offset]"`
+	Limit    int           `url:"page[limit]"`
+}
+
+func NewIdMappingParams(apiToken string, filter *IdMappingFilter, offset, limit int) (*IdMappingParams, error) {
+	if limit <= 0 {
+		limit = IdMappingMaxLimit
+	}
+	if limit > IdMappingMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", IdMappingMaxLimit)
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	p := &IdMappingParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Offset:   offset,
+		Limit:    limit,
+	}
+	if filter != nil {
+		p.Symbol = filter.Symbol
+		p.Exchange = filter.Exchange
+		p.Isin = filter.Isin
+		p.Figi = filter.Figi
+		p.Lei = filter.Lei
+		p.Cik = filter.Cik
+		p.Cusip = filter.Cusip
+	}
+	return p, nil
+}
+
+func (i *IdMappingParams) GetEncoded() (string, error) {
+	q, err := query.Values(i)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (i *IdMappingParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("id-mapping")
+	encoded, err := i.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// IdMapping links an EODHD symbol such as AAPL.US to its other identifiers.
+type IdMapping struct {
+	Symbol string `json:"symbol"`
+	Isin   string `json:"isin"`
+	Figi   string `json:"figi"`
+	Lei    string `json:"lei"`
+	Cusip  string `json:"cusip"`
+	Cik    string `json:"cik"`
+}
+
+// Exchange returns the exchange code of Symbol, e.g. US for AAPL.US.
+func (m *IdMapping) Exchange() string {
+	if i := strings.LastIndex(m.Symbol, "."); i >= 0 {
+		return m.Symbol[i+1:]
+	}
+	return ""
+}
+
+// IsPrimaryListing reports whether the listing exchange is in the country that issued the ISIN.
+func (m *IdMapping) IsPrimaryListing() bool {
+	return isPrimaryListing(m.Isin, m.Exchange())
+}
+
+type idMappingResponse struct {
+	Meta struct {
+		Total int `json:"total"`
+	} `json:"meta"`
+	Data []*IdMapping `json:"data"`
+}
+
+type IdMappingService struct {
+	c RequestClient
+}
+
+func NewIdMappingService(c RequestClient) *IdMappingService {
+	return &IdMappingService{
+		c: c,
+	}
+}
+
+// GetIdMappings fetches a single page of mappings.
+func (i *IdMappingService) GetIdMappings(filter *IdMappingFilter, offset, limit int) ([]*IdMapping, *Response, error) {
+	params, err := NewIdMappingParams(i.c.GetApiToken(), filter, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := params.BuildPath(i.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data idMappingResponse
+	res, err := i.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data.Data, res, nil
+}
+
+// GetAllIdMappings fetches every page of mappings.
+func (i *IdMappingService) GetAllIdMappings(filter *IdMappingFilter) ([]*IdMapping, *Response, error) {
+	mappings := make([]*IdMapping, 0)
+	var res *Response
+	for offset := 0; ; offset += IdMappingMaxLimit {
+		page, r, err := i.GetIdMappings(filter, offset, IdMappingMaxLimit)
+		res = r
+		if err != nil {
+			return nil, res, err
+		}
+		mappings = append(mappings, page...)
+		if len(page) < IdMappingMaxLimit {
+			return mappings, res, nil
+		}
+	}
+}
+
+// Resolve returns the EODHD symbol of an identifier, or an empty string if it is unknown.
+// An identifier such as an ISIN maps to every listing of the security, the primary listing is preferred
+// and ties are broken by symbol so that the result does not depend on the order of the API.
+func (i *IdMappingService) Resolve(idType IdType, id string) (string, *Response, error) {
+	filter := &IdMappingFilter{}
+	switch idType {
+	case IdIsin:
+		filter.Isin = &id
+	case IdCusip:
+		filter.Cusip = &id
+	case IdFigi:
+		filter.Figi = &id
+	case IdLei:
+		filter.Lei = &id
+	case IdCik:
+		filter.Cik = &id
+	default:
+		return "", nil, fmt.Errorf("unknown identifier type %q", idType)
+	}
+
+	mappings, res, err := i.GetAllIdMappings(filter)
+	if err != nil {
+		return "", res, err
+	}
+	return preferredSymbol(mappings), res, nil
+}
+
+func preferredSymbol(mappings []*IdMapping) string {
+	var best *IdMapping
+	for _, m := range mappings {
+		if m.Symbol == "" {
+			continue
+		}
+		if best == nil || m.IsPrimaryListing() && !best.IsPrimaryListing() ||
+			m.IsPrimaryListing() == best.IsPrimaryListing() && m.Symbol < best.Symbol {
+			best = m
+		}
+	}
+	if best == nil {
+		return ""
+	}
+	return best.Symbol
+}
+
+// DefaultIdMappingMissTTL is how long an identifier without a mapping is cached before it is asked for again.
+const DefaultIdMappingMissTTL = 7 * 24 * time.Hour
+
+// IdMappingCacheEntry is a resolved identifier. An empty Symbol records an identifier known to be unmapped.
+type IdMappingCacheEntry struct {
+	Symbol     string    `json:"symbol"`
+	ResolvedAt time.Time `json:"resolved_at"`
+}
+
+// IdMappingCache stores resolved identifiers. Caches that also implement IdMappingCacheSaver,
+// such as FileIdMappingCache, are saved by IdResolver after every batch.
+type IdMappingCache interface {
+	Get(idType IdType, id string) (IdMappingCacheEntry, bool)
+	Put(idType IdType, id string, entry IdMappingCacheEntry)
+}
+
+// IdMappingCacheSaver persists a cache.
+type IdMappingCacheSaver interface {
+	Save() error
+}
+
+// FileIdMappingCache is an IdMappingCache kept in memory and persisted as JSON by Save.
+type FileIdMappingCache struct {
+	path    string
+	mu      sync.RWMutex
+	entries map[string]IdMappingCacheEntry
+}
+
+// NewFileIdMappingCache loads the cache at path. A missing file starts an empty cache.
+func NewFileIdMappingCache(path string) (*FileIdMappingCache, error) {
+	c := &FileIdMappingCache{path: path, entries: make(map[string]IdMappingCacheEntry)}
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(b, &c.entries); err != nil {
+		return nil, fmt.Errorf("reading id mapping cache %s: %w", path, err)
+	}
+	return c, nil
+}
+
+func idMappingKey(idType IdType, id string) string {
+	return string(idType) + ":" + strings.ToUpper(strings.TrimSpace(id))
+}
+
+func (c *FileIdMappingCache) Get(idType IdType, id string) (IdMappingCacheEntry, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[idMappingKey(idType, id)]
+	return entry, ok
+}
+
+func (c *FileIdMappingCache) Put(idType IdType, id string, entry IdMappingCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[idMappingKey(idType, id)] = entry
+}
+
+// Save writes the cache to its file, replacing the previous version atomically.
+func (c *FileIdMappingCache) Save() error {
+	c.mu.RLock()
+	b, err := json.MarshalIndent(c.entries, "", "  ")
+	c.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), filepath.Base(c.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+	if _, err = tmp.Write(b); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.path)
+}
+
+// DefaultIdResolverWorkers is the number of identifiers an IdResolver resolves at the same time.
+const DefaultIdResolverWorkers = 4
+
+// IdResolver resolves identifiers in batches, asking the API only for identifiers missing from the cache.
+// The API has no multi identifier filter, so each missing identifier costs one lookup. Lookups run
+// concurrently on Workers goroutines, which share the rate limiter and the quota of the client.
+type IdResolver struct {
+	s     *IdMappingService
+	cache IdMappingCache
+	// MissTTL is how long an unmapped identifier is trusted. Zero caches misses forever.
+	MissTTL time.Duration
+	Workers int
+	now     func() time.Time
+}
+
+func NewIdResolver(s *IdMappingService, cache IdMappingCache) *IdResolver {
+	return &IdResolver{
+		s:       s,
+		cache:   cache,
+		MissTTL: DefaultIdMappingMissTTL,
+		Workers: DefaultIdResolverWorkers,
+		now:     time.Now,
+	}
+}
+
+func (r *IdResolver) cached(idType IdType, id string) (string, bool) {
+	entry, ok := r.cache.Get(idType, id)
+	if !ok {
+		return "", false
+	}
+	if entry.Symbol == "" && r.MissTTL > 0 && r.now().Sub(entry.ResolvedAt) > r.MissTTL {
+		return "", false
+	}
+	return entry.Symbol, true
+}
+
+// ResolveAll maps each identifier to its EODHD symbol. Unmapped identifiers map to an empty string.
+// Results resolved before an error are kept in the cache, which is saved if it is an IdMappingCacheSaver,
+// and the errors of all failed lookups are joined.
+func (r *IdResolver) ResolveAll(idType IdType, ids []string) (map[string]string, error) {
+	symbols := make(map[string]string, len(ids))
+	pending := make([]string, 0)
+	queued := make(map[string]bool)
+	for _, id := range ids {
+		if symbol, ok := r.cached(idType, id); ok {
+			symbols[id] = symbol
+			continue
+		}
+		if key := idMappingKey(idType, id); !queued[key] {
+			queued[key] = true
+			pending = append(pending, id)
+		}
+	}
+
+	workers := r.Workers
+	if workers <= 0 {
+		workers = 1
+	}
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		errs     []error
+		resolved = make(map[string]string, len(pending))
+	)
+	jobs := make(chan string)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range jobs {
+				symbol, _, err := r.s.Resolve(idType, id)
+				mu.Lock()
+				if err != nil {
+					errs = append(errs, fmt.Errorf("resolving %s %s: %w", idType, id, err))
+				} else {
+					r.cache.Put(idType, id, IdMappingCacheEntry{Symbol: symbol, ResolvedAt: r.now()})
+					resolved[idMappingKey(idType, id)] = symbol
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for _, id := range pending {
+		jobs <- id
+	}
+	close(jobs)
+	wg.Wait()
+
+	// duplicates in ids share the lookup of their first spelling
+	for _, id := range ids {
+		if _, ok := symbols[id]; ok {
+			continue
+		}
+		if symbol, ok := resolved[idMappingKey(idType, id)]; ok {
+			symbols[id] = symbol
+		}
+	}
+	if saver, ok := r.cache.(IdMappingCacheSaver); ok && len(resolved) > 0 {
+		if err := saver.Save(); err != nil {
+			errs = append(errs, fmt.Errorf("saving id mapping cache: %w", err))
+		}
+	}
+	return symbols, errors.Join(errs...)
+}
@@ -0,0 +1,124 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestIdMappingParams_BuildPath(t *testing.T) {
+	p, err := NewIdMappingParams("test-token", &IdMappingFilter{Isin: GetPtrString("US0378331005")}, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/id-mapping?api_token=test-token&filter%5Bisin%5D=US0378331005&fmt=json&page%5Blimit%5D=10&page%5Boffset%5D=0"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestFileIdMappingCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids.json")
+	c, err := NewFileIdMappingCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+	c.Put(IdIsin, "us0378331005", IdMappingCacheEntry{Symbol: "AAPL.US", ResolvedAt: now})
+	c.Put(IdCusip, "000000000", IdMappingCacheEntry{ResolvedAt: now})
+	if err = c.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := NewFileIdMappingCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok := loaded.Get(IdIsin, "US0378331005"); !ok || entry.Symbol != "AAPL.US" {
+		t.Errorf("expected AAPL.US, got %q", entry.Symbol)
+	}
+	if entry, ok := loaded.Get(IdCusip, "000000000"); !ok || entry.Symbol != "" || !entry.ResolvedAt.Equal(now) {
+		t.Errorf("expected cached miss, got %+v %v", entry, ok)
+	}
+
+	// cached identifiers are never sent to the API
+	r := NewIdResolver(nil, loaded)
+	symbols, err := r.ResolveAll(IdIsin, []string{"US0378331005"})
+	if err != nil || symbols["US0378331005"] != "AAPL.US" {
+		t.Errorf("unexpected resolve result %v %v", symbols, err)
+	}
+}
+
+func TestIdResolver_ResolveAll(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Query().Get("filter[isin]") {
+		case "US0378331005":
+			_, _ = w.Write([]byte(`{"meta":{"total":3},"data":[
+				{"symbol":"AAPL.MX","isin":"US0378331005"},
+				{"symbol":"APC.XETRA","isin":"US0378331005"},
+				{"symbol":"AAPL.US","isin":"US0378331005"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"meta":{"total":0},"data":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "ids.json")
+	cache, err := NewFileIdMappingCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	r := NewIdResolver(c.IdMappingService, cache)
+	r.now = func() time.Time { return now }
+
+	symbols, err := r.ResolveAll(IdIsin, []string{"US0378331005", "us0378331005", "XX0000000000"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if symbols["US0378331005"] != "AAPL.US" || symbols["us0378331005"] != "AAPL.US" || symbols["XX0000000000"] != "" {
+		t.Errorf("unexpected symbols %v", symbols)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 lookups, got %d", calls)
+	}
+	saved, err := NewFileIdMappingCache(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry, ok := saved.Get(IdIsin, "US0378331005"); !ok || entry.Symbol != "AAPL.US" {
+		t.Errorf("expected the batch to be saved, got %+v %v", entry, ok)
+	}
+
+	// misses are asked for again once MissTTL has passed
+	now = now.Add(DefaultIdMappingMissTTL + time.Hour)
+	if _, err = r.ResolveAll(IdIsin, []string{"US0378331005", "XX0000000000"}); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 3 {
+		t.Errorf("expected only the expired miss to be looked up again, got %d lookups", calls)
+	}
+}
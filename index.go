@@ -0,0 +1,187 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const indexExchange = "INDX"
+
+type IndexParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Symbol   string        `url:"-"`
+	Filter   string        `url:"filter"`
+}
+
+func (i *IndexParams) GetEncoded() (string, error) {
+	q, err := query.Values(i)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (i *IndexParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("fundamentals/%s", i.Symbol)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := i.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// IndexConstituent is a current member of an index.
+type IndexConstituent struct {
+	Code     string `json:"Code"`
+	Exchange string `json:"Exchange"`
+	Name     string `json:"Name"`
+	Sector   string `json:"Sector"`
+	Industry string `json:"Industry"`
+}
+
+// HistoricalConstituent is a past or present member of an index. EndDate is zero while the member is active.
+type HistoricalConstituent struct {
+	Code        string   `json:"Code"`
+	Name        string   `json:"Name"`
+	StartDate   Date     `json:"StartDate"`
+	EndDate     Date     `json:"EndDate"`
+	IsActiveNow FlexBool `json:"IsActiveNow"`
+	IsDelisted  FlexBool `json:"IsDelisted"`
+}
+
+// IsMemberOn reports whether the constituent was in the index on date.
+// An unknown StartDate is taken as a member since inception. Without an EndDate the constituent
+// must still be active, since a removal is not always dated.
+func (h *HistoricalConstituent) IsMemberOn(date time.Time) bool {
+	if !h.StartDate.IsZero() && h.StartDate.After(date) {
+		return false
+	}
+	if !h.EndDate.IsZero() {
+		return h.EndDate.After(date)
+	}
+	return bool(h.IsActiveNow)
+}
+
+type IndexConstituents []*IndexConstituent
+
+func (c *IndexConstituents) UnmarshalJSON(b []byte) error {
+	constituents := make(IndexConstituents, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		ic := new(IndexConstituent)
+		if err := dec.Decode(ic); err != nil {
+			return err
+		}
+		constituents = append(constituents, ic)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*c = constituents
+	return nil
+}
+
+type HistoricalConstituents []*HistoricalConstituent
+
+func (c *HistoricalConstituents) UnmarshalJSON(b []byte) error {
+	constituents := make(HistoricalConstituents, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		hc := new(HistoricalConstituent)
+		if err := dec.Decode(hc); err != nil {
+			return err
+		}
+		constituents = append(constituents, hc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*c = constituents
+	return nil
+}
+
+// Index holds the current and historical members of an index.
+type Index struct {
+	General    FundamentalsGeneral    `json:"General"`
+	Components IndexConstituents      `json:"Components"`
+	Historical HistoricalConstituents `json:"HistoricalTickerComponents"`
+}
+
+// MembersAsOf returns the codes of the members on date, sorted, including those delisted since.
+func (i *Index) MembersAsOf(date time.Time) []string {
+	seen := make(map[string]bool)
+	members := make([]string, 0)
+	for _, h := range i.Historical {
+		if h.IsMemberOn(date) && !seen[h.Code] {
+			seen[h.Code] = true
+			members = append(members, h.Code)
+		}
+	}
+	sort.Strings(members)
+	return members
+}
+
+type IndexService struct {
+	c RequestClient
+}
+
+func NewIndexService(c RequestClient) *IndexService {
+	return &IndexService{
+		c: c,
+	}
+}
+
+// GetIndex returns the constituents of an index such as GSPC.INDX. The INDX suffix may be left out.
+// It is a fundamentals request and costs FundamentalsCallCost calls.
+func (i *IndexService) GetIndex(symbol string) (*Index, *Response, error) {
+	if !strings.Contains(symbol, ".") {
+		symbol = fmt.Sprintf("%s.%s", symbol, indexExchange)
+	}
+	params := &IndexParams{
+		ApiToken: i.c.GetApiToken(),
+		Format:   formatJson,
+		Symbol:   symbol,
+		Filter:   "General,Components,HistoricalTickerComponents",
+	}
+
+	u, err := params.BuildPath(i.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	req = withCallCost(req, FundamentalsCallCost)
+
+	data := new(Index)
+	res, err := i.c.Do(req, data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// MembersAsOf returns the members of an index on date.
+func (i *IndexService) MembersAsOf(symbol string, date time.Time) ([]string, *Response, error) {
+	index, res, err := i.GetIndex(symbol)
+	if err != nil {
+		return nil, res, err
+	}
+	return index.MembersAsOf(date), res, nil
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestIndexParams_BuildPath(t *testing.T) {
+	p := &IndexParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		Symbol:   "GSPC.INDX",
+		Filter:   "Components",
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/fundamentals/GSPC.INDX?api_token=test-token&filter=Components&fmt=json"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestIndex_MembersAsOf(t *testing.T) {
+	body := []byte(`{
+		"General":{"Code":"GSPC","Type":"INDEX"},
+		"Components":{"0":{"Code":"AAPL","Exchange":"US","Name":"Apple Inc"}},
+		"HistoricalTickerComponents":{
+			"0":{"Code":"AAPL","Name":"Apple Inc","StartDate":"1982-11-30","EndDate":null,"IsActiveNow":1,"IsDelisted":0},
+			"1":{"Code":"TWTR","Name":"Twitter Inc","StartDate":"2018-06-07","EndDate":"2022-11-01","IsActiveNow":0,"IsDelisted":1},
+			"2":{"Code":"TSLA","Name":"Tesla Inc","StartDate":"2020-12-21","EndDate":null,"IsActiveNow":1,"IsDelisted":0},
+			"3":{"Code":"GE","Name":"General Electric","StartDate":null,"EndDate":null,"IsActiveNow":1,"IsDelisted":0},
+			"4":{"Code":"XRX","Name":"Xerox Holdings","StartDate":null,"EndDate":"2021-06-01","IsActiveNow":0,"IsDelisted":0},
+			"5":{"Code":"NONE","Name":"Unknown","StartDate":null,"EndDate":null,"IsActiveNow":0,"IsDelisted":0},
+			"6":{"Code":"GPS","Name":"Gap Inc","StartDate":"2015-03-20","EndDate":null,"IsActiveNow":0,"IsDelisted":0}
+		}
+	}`)
+	var index Index
+	if err := json.Unmarshal(body, &index); err != nil {
+		t.Fatal(err)
+	}
+	if len(index.Components) != 1 || !bool(index.Historical[1].IsDelisted) {
+		t.Fatalf("unexpected index %+v", index)
+	}
+
+	members := index.MembersAsOf(time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC))
+	if !reflect.DeepEqual(members, []string{"AAPL", "GE", "TWTR", "XRX"}) {
+		t.Errorf("expected [AAPL GE TWTR XRX], got %v", members)
+	}
+	members = index.MembersAsOf(time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC))
+	if !reflect.DeepEqual(members, []string{"AAPL", "GE", "TSLA"}) {
+		t.Errorf("expected [AAPL GE TSLA], got %v", members)
+	}
+}
+
+func TestIndexService_GetIndex(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/fundamentals/GSPC.INDX" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"General":{"Code":"GSPC"},"Components":{"0":{"Code":"AAPL","Exchange":"US"}}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", SetDailyQuota(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	index, _, err := c.IndexService.GetIndex("GSPC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(index.Components) != 1 {
+		t.Errorf("unexpected components %v", index.Components)
+	}
+	if c.GetQuota().Used() != FundamentalsCallCost {
+		t.Errorf("expected %d calls spent, got %d", FundamentalsCallCost, c.GetQuota().Used())
+	}
+}
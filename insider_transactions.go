@@ -0,0 +1,192 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+const InsiderTransactionsMaxLimit = 1000
+
+const (
+	InsiderAcquired = "A"
+	InsiderDisposed = "D"
+)
+
+// SEC Form 4 transaction codes. Only P and S are trades in the open market, the other codes
+// move shares without the insider buying or selling at a price of their choosing.
+const (
+	InsiderCodePurchase    = "P"
+	InsiderCodeSale        = "S"
+	InsiderCodeGrant       = "A"
+	InsiderCodeExercise    = "M"
+	InsiderCodeGift        = "G"
+	InsiderCodeTaxWithhold = "F"
+)
+
+type InsiderTransactionsParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Code     *string       `url:"code,omitempty"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+	Limit    int           `url:"limit,omitempty"`
+}
+
+func (i *InsiderTransactionsParams) GetEncoded() (string, error) {
+	if i.FromTime != nil {
+		from := i.FromTime.Format(urlDateFormat)
+		i.From = &from
+	}
+	if i.ToTime != nil {
+		to := i.ToTime.Format(urlDateFormat)
+		i.To = &to
+	}
+	q, err := query.Values(i)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (i *InsiderTransactionsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("insider-transactions")
+	encoded, err := i.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// InsiderTransaction is a Form 4 filing. TransactionCode is the SEC code such as P for purchase or S for sale.
+type InsiderTransaction struct {
+	Code                  string   `json:"code"`
+	Exchange              string   `json:"exchange"`
+	Date                  Date     `json:"date"`
+	ReportDate            Date     `json:"reportDate"`
+	OwnerCik              *string  `json:"ownerCik"`
+	OwnerName             string   `json:"ownerName"`
+	OwnerRelationship     *string  `json:"ownerRelationship"`
+	OwnerTitle            string   `json:"ownerTitle"`
+	TransactionDate       Date     `json:"transactionDate"`
+	TransactionCode       string   `json:"transactionCode"`
+	TransactionAmount     float64  `json:"transactionAmount"`
+	TransactionPrice      *float64 `json:"transactionPrice"`
+	AcquiredDisposed      string   `json:"transactionAcquiredDisposed"`
+	PostTransactionAmount *float64 `json:"postTransactionAmount"`
+	SecLink               string   `json:"secLink"`
+}
+
+// Symbol returns the transaction's symbol such as AAPL.US.
+func (i *InsiderTransaction) Symbol() string {
+	return fmt.Sprintf("%s.%s", i.Code, i.Exchange)
+}
+
+type InsiderTransactionsService struct {
+	c RequestClient
+}
+
+func NewInsiderTransactionsService(c RequestClient) *InsiderTransactionsService {
+	return &InsiderTransactionsService{
+		c: c,
+	}
+}
+
+// GetInsiderTransactions returns the transactions of code, or of all symbols when code is nil.
+func (i *InsiderTransactionsService) GetInsiderTransactions(code *string, from, to *time.Time, limit int) ([]*InsiderTransaction, *Response, error) {
+	if limit < 0 || limit > InsiderTransactionsMaxLimit {
+		return nil, nil, fmt.Errorf("limit must be between 0 and %d", InsiderTransactionsMaxLimit)
+	}
+	params := &InsiderTransactionsParams{
+		ApiToken: i.c.GetApiToken(),
+		Format:   formatJson,
+		Code:     code,
+		FromTime: from,
+		ToTime:   to,
+		Limit:    limit,
+	}
+
+	u, err := params.BuildPath(i.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*InsiderTransaction
+	res, err := i.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// InsiderNetActivity is the insider buying and selling of a symbol over a window ending on Date.
+type InsiderNetActivity struct {
+	Date         time.Time
+	SharesBought float64
+	SharesSold   float64
+	NetShares    float64
+	// NetValue only includes transactions with a price
+	NetValue     float64
+	Transactions int
+}
+
+// NetInsiderBuying aggregates the open market purchases and sales dated in the days up to and including asOf,
+// keyed by symbol. Grants, option exercises, gifts and other codes are left out.
+func NetInsiderBuying(transactions []*InsiderTransaction, asOf time.Time, days int) map[string]*InsiderNetActivity {
+	start := asOf.AddDate(0, 0, -days)
+	activity := make(map[string]*InsiderNetActivity)
+	for _, t := range transactions {
+		if !t.TransactionDate.After(start) || t.TransactionDate.After(asOf) {
+			continue
+		}
+		if t.TransactionCode != InsiderCodePurchase && t.TransactionCode != InsiderCodeSale {
+			continue
+		}
+		a, ok := activity[t.Symbol()]
+		if !ok {
+			a = &InsiderNetActivity{Date: asOf}
+			activity[t.Symbol()] = a
+		}
+
+		sign := 1.0
+		if t.TransactionCode == InsiderCodeSale {
+			sign = -1
+			a.SharesSold += t.TransactionAmount
+		} else {
+			a.SharesBought += t.TransactionAmount
+		}
+		a.NetShares += sign * t.TransactionAmount
+		if t.TransactionPrice != nil {
+			a.NetValue += sign * t.TransactionAmount * *t.TransactionPrice
+		}
+		a.Transactions++
+	}
+	return activity
+}
+
+// RollingNetInsiderBuying evaluates NetInsiderBuying for every day from..to and returns a daily series per symbol.
+// Days without activity in the window are left out.
+func RollingNetInsiderBuying(transactions []*InsiderTransaction, from, to time.Time, days int) map[string][]*InsiderNetActivity {
+	series := make(map[string][]*InsiderNetActivity)
+	for _, w := range splitDateWindow(from, to, 1) {
+		for symbol, a := range NetInsiderBuying(transactions, w[0], days) {
+			series[symbol] = append(series[symbol], a)
+		}
+	}
+	return series
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestInsiderTransactionsParams_BuildPath(t *testing.T) {
+	p := &InsiderTransactionsParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		Code:     GetPtrString("AAPL.US"),
+		FromTime: GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Limit:    100,
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/insider-transactions?api_token=test-token&code=AAPL.US&fmt=json&from=2024-01-01&limit=100"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestNetInsiderBuying(t *testing.T) {
+	body := []byte(`[
+		{"code":"AAPL","exchange":"US","transactionDate":"2024-01-10","transactionCode":"P","transactionAmount":1000,"transactionPrice":180,"transactionAcquiredDisposed":"A"},
+		{"code":"AAPL","exchange":"US","transactionDate":"2024-01-20","transactionCode":"S","transactionAmount":400,"transactionPrice":190,"transactionAcquiredDisposed":"D"},
+		{"code":"AAPL","exchange":"US","transactionDate":"2024-01-22","transactionCode":"M","transactionAmount":20000,"transactionPrice":0,"transactionAcquiredDisposed":"A"},
+		{"code":"AAPL","exchange":"US","transactionDate":"2024-01-22","transactionCode":"F","transactionAmount":8000,"transactionPrice":191,"transactionAcquiredDisposed":"D"},
+		{"code":"AAPL","exchange":"US","transactionDate":"2023-11-01","transactionCode":"S","transactionAmount":5000,"transactionPrice":170,"transactionAcquiredDisposed":"D"}
+	]`)
+	var txs []*InsiderTransaction
+	if err := json.Unmarshal(body, &txs); err != nil {
+		t.Fatal(err)
+	}
+
+	activity := NetInsiderBuying(txs, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), 30)
+	a := activity["AAPL.US"]
+	if a == nil {
+		t.Fatal("expected activity for AAPL.US")
+	}
+	if a.NetShares != 600 || a.NetValue != 180000-76000 || a.Transactions != 2 {
+		t.Errorf("unexpected activity %+v", a)
+	}
+
+	series := RollingNetInsiderBuying(txs, time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 16, 0, 0, 0, 0, time.UTC), 7)
+	if len(series["AAPL.US"]) != 2 || series["AAPL.US"][0].NetShares != 1000 {
+		t.Errorf("unexpected rolling series %v", series["AAPL.US"])
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+type LogoFormat string
+
+const (
+	LogoPng LogoFormat = "png"
+	LogoSvg LogoFormat = "svg"
+)
+
+var logoMediaTypes = map[LogoFormat]string{
+	LogoPng: "image/png",
+	LogoSvg: "image/svg+xml",
+}
+
+// LogoParams builds the path of a company logo. Logos are served outside of the API path and need no token.
+type LogoParams struct {
+	Symbol   string
+	Exchange string
+	Format   LogoFormat
+}
+
+func (l *LogoParams) GetEncoded() (string, error) {
+	return "", nil
+}
+
+func (l *LogoParams) BuildPath(baseUrl *url.URL) (string, error) {
+	var basePath string
+	switch l.Format {
+	case LogoPng:
+		basePath = fmt.Sprintf("/img/logos/%s/%s.png", l.Exchange, l.Symbol)
+	case LogoSvg:
+		basePath = fmt.Sprintf("/img/logos-svg/%s/%s.svg", l.Exchange, l.Symbol)
+	default:
+		return "", fmt.Errorf("unknown logo format %q", l.Format)
+	}
+	bURL := baseUrl.ResolveReference(&url.URL{Path: basePath})
+	return bURL.String(), nil
+}
+
+type LogoService struct {
+	c RequestClient
+}
+
+func NewLogoService(c RequestClient) *LogoService {
+	return &LogoService{
+		c: c,
+	}
+}
+
+// GetLogo returns the logo of symbol on exchange, e.g. AAPL on US.
+func (l *LogoService) GetLogo(symbol, exchange string, format LogoFormat) ([]byte, *Response, error) {
+	var buf bytes.Buffer
+	res, err := l.WriteLogo(&buf, symbol, exchange, format)
+	if err != nil {
+		return nil, res, err
+	}
+	return buf.Bytes(), res, nil
+}
+
+// WriteLogo streams the logo of symbol on exchange to w.
+func (l *LogoService) WriteLogo(w io.Writer, symbol, exchange string, format LogoFormat) (*Response, error) {
+	params := &LogoParams{
+		Symbol:   symbol,
+		Exchange: exchange,
+		Format:   format,
+	}
+	u, err := params.BuildPath(l.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	// Do rejects a response of another type before anything is written to w
+	headers := map[string]string{"Accept": logoMediaTypes[format]}
+	req, err := l.c.NewGetRequest(u, &headers)
+	if err != nil {
+		return nil, err
+	}
+	// Logos are static files outside of the metered API
+	req = withCallCost(req, 0)
+
+	return l.c.Do(req, w)
+}
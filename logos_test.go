@@ -0,0 +1,80 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestLogoParams_BuildPath(t *testing.T) {
+	u, _ := url.Parse("https://eodhd.com/api")
+	p := &LogoParams{Symbol: "AAPL", Exchange: "US", Format: LogoSvg}
+	expected := "https://eodhd.com/img/logos-svg/US/AAPL.svg"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestLogoService_GetLogo(t *testing.T) {
+	png := []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/img/logos/US/AAPL.png" {
+			w.Header().Set("Content-Type", "image/png")
+			_, _ = w.Write(png)
+			return
+		}
+		if r.URL.Path == "/img/logos/US/MSFT.png" {
+			w.Header().Set("Content-Type", "image/svg+xml")
+			_, _ = w.Write([]byte(`<svg xmlns="http://www.w3.org/2000/svg"/>`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":"not found"}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	logo, _, err := c.LogoService.GetLogo("AAPL", "US", LogoPng)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(logo, png) {
+		t.Errorf("unexpected logo bytes %v", logo)
+	}
+	if c.GetQuota().Used() != 0 {
+		t.Errorf("expected logos to spend no quota, got %d", c.GetQuota().Used())
+	}
+
+	var buf bytes.Buffer
+	if _, err = c.LogoService.WriteLogo(&buf, "NONE", "US", LogoPng); !errors.Is(err, ErrUnexpectedContentType) {
+		t.Errorf("expected ErrUnexpectedContentType, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Error("expected nothing written for an error response")
+	}
+
+	if _, err = c.LogoService.WriteLogo(&buf, "MSFT", "US", LogoPng); !errors.Is(err, ErrUnexpectedContentType) {
+		t.Errorf("expected ErrUnexpectedContentType for an svg body, got %v", err)
+	}
+	if buf.Len() != 0 {
+		t.Error("expected nothing written for a logo of the wrong format")
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"time"
+)
+
+type MacroIndicator string
+
+const (
+	MacroRealInterestRate                  MacroIndicator = "real_interest_rate"
+	MacroPopulationTotal                   MacroIndicator = "population_total"
+	MacroPopulationGrowthAnnual            MacroIndicator = "population_growth_annual"
+	MacroInflationConsumerPricesAnnual     MacroIndicator = "inflation_consumer_prices_annual"
+	MacroConsumerPriceIndex                MacroIndicator = "consumer_price_index"
+	MacroGdpCurrentUsd                     MacroIndicator = "gdp_current_usd"
+	MacroGdpPerCapitaUsd                   MacroIndicator = "gdp_per_capita_usd"
+	MacroGdpGrowthAnnual                   MacroIndicator = "gdp_growth_annual"
+	MacroDebtPercentGdp                    MacroIndicator = "debt_percent_gdp"
+	MacroNetTradesGoodsServices            MacroIndicator = "net_trades_goods_services"
+	MacroInflationGdpDeflatorAnnual        MacroIndicator = "inflation_gdp_deflator_annual"
+	MacroAgricultureValueAddedPercentGdp   MacroIndicator = "agriculture_value_added_percent_gdp"
+	MacroIndustryValueAddedPercentGdp      MacroIndicator = "industry_value_added_percent_gdp"
+	MacroServicesValueAddedPercentGdp      MacroIndicator = "services_value_added_percent_gdp"
+	MacroExportsOfGoodsServicesPercentGdp  MacroIndicator = "exports_of_goods_services_percent_gdp"
+	MacroImportsOfGoodsServicesPercentGdp  MacroIndicator = "imports_of_goods_services_percent_gdp"
+	MacroGrossCapitalFormationPercentGdp   MacroIndicator = "gross_capital_formation_percent_gdp"
+	MacroNetMigration                      MacroIndicator = "net_migration"
+	MacroGniUsd                            MacroIndicator = "gni_usd"
+	MacroGniPerCapitaUsd                   MacroIndicator = "gni_per_capita_usd"
+	MacroGniPppUsd                         MacroIndicator = "gni_ppp_usd"
+	MacroGniPerCapitaPppUsd                MacroIndicator = "gni_per_capita_ppp_usd"
+	MacroIncomeShareLowestTwenty           MacroIndicator = "income_share_lowest_twenty"
+	MacroLifeExpectancy                    MacroIndicator = "life_expectancy"
+	MacroFertilityRate                     MacroIndicator = "fertility_rate"
+	MacroPrevalenceHivTotal                MacroIndicator = "prevalence_hiv_total"
+	MacroCo2EmissionsTonsPerCapita         MacroIndicator = "co2_emissions_tons_per_capita"
+	MacroSurfaceAreaKm                     MacroIndicator = "surface_area_km"
+	MacroPovertyLinesPercentPopulation     MacroIndicator = "poverty_poverty_lines_percent_population"
+	MacroRevenueExcludingGrantsPercentGdp  MacroIndicator = "revenue_excluding_grants_percent_gdp"
+	MacroCashSurplusDeficitPercentGdp      MacroIndicator = "cash_surplus_deficit_percent_gdp"
+	MacroStartupProceduresRegister         MacroIndicator = "startup_procedures_register"
+	MacroMarketCapDomesticCompaniesPercent MacroIndicator = "market_cap_domestic_companies_percent_gdp"
+	MacroMobileSubscriptionsPerHundred     MacroIndicator = "mobile_subscriptions_per_hundred"
+	MacroInternetUsersPerHundred           MacroIndicator = "internet_users_per_hundred"
+	MacroHighTechnologyExportsPercentTotal MacroIndicator = "high_technology_exports_percent_total"
+	MacroMerchandiseTradePercentGdp        MacroIndicator = "merchandise_trade_percent_gdp"
+	MacroTotalDebtServicePercentGni        MacroIndicator = "total_debt_service_percent_gni"
+	MacroUnemploymentTotalPercent          MacroIndicator = "unemployment_total_percent"
+)
+
+var macroIndicators = map[MacroIndicator]bool{
+	MacroRealInterestRate:                  true,
+	MacroPopulationTotal:                   true,
+	MacroPopulationGrowthAnnual:            true,
+	MacroInflationConsumerPricesAnnual:     true,
+	MacroConsumerPriceIndex:                true,
+	MacroGdpCurrentUsd:                     true,
+	MacroGdpPerCapitaUsd:                   true,
+	MacroGdpGrowthAnnual:                   true,
+	MacroDebtPercentGdp:                    true,
+	MacroNetTradesGoodsServices:            true,
+	MacroInflationGdpDeflatorAnnual:        true,
+	MacroAgricultureValueAddedPercentGdp:   true,
+	MacroIndustryValueAddedPercentGdp:      true,
+	MacroServicesValueAddedPercentGdp:      true,
+	MacroExportsOfGoodsServicesPercentGdp:  true,
+	MacroImportsOfGoodsServicesPercentGdp:  true,
+	MacroGrossCapitalFormationPercentGdp:   true,
+	MacroNetMigration:                      true,
+	MacroGniUsd:                            true,
+	MacroGniPerCapitaUsd:                   true,
+	MacroGniPppUsd:                         true,
+	MacroGniPerCapitaPppUsd:                true,
+	MacroIncomeShareLowestTwenty:           true,
+	MacroLifeExpectancy:                    true,
+	MacroFertilityRate:                     true,
+	MacroPrevalenceHivTotal:                true,
+	MacroCo2EmissionsTonsPerCapita:         true,
+	MacroSurfaceAreaKm:                     true,
+	MacroPovertyLinesPercentPopulation:     true,
+	MacroRevenueExcludingGrantsPercentGdp:  true,
+	MacroCashSurplusDeficitPercentGdp:      true,
+	MacroStartupProceduresRegister:         true,
+	MacroMarketCapDomesticCompaniesPercent: true,
+	MacroMobileSubscriptionsPerHundred:     true,
+	MacroInternetUsersPerHundred:           true,
+	MacroHighTechnologyExportsPercentTotal: true,
+	MacroMerchandiseTradePercentGdp:        true,
+	MacroTotalDebtServicePercentGni:        true,
+	MacroUnemploymentTotalPercent:          true,
+}
+
+type MacroParams struct {
+	ApiToken  string         `url:"api_token"`
+	Format    RequestFormat  `url:"fmt"`
+	Country   string         `url:"-"`
+	Indicator MacroIndicator `url:"indicator"`
+}
+
+func NewMacroParams(apiToken, country string, indicator MacroIndicator) (*MacroParams, error) {
+	if len(country) != 3 {
+		return nil, fmt.Errorf("country must be an ISO 3166 alpha-3 code, got %q", country)
+	}
+	if !macroIndicators[indicator] {
+		return nil, fmt.Errorf("unknown macro indicator %q", indicator)
+	}
+	return &MacroParams{
+		ApiToken:  apiToken,
+		Format:    formatJson,
+		Country:   country,
+		Indicator: indicator,
+	}, nil
+}
+
+func (m *MacroParams) GetEncoded() (string, error) {
+	q, err := query.Values(m)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (m *MacroParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("macro-indicator/%s", m.Country)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := m.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// MacroValue is the value of an indicator for one period, usually a year ending on Date.
+type MacroValue struct {
+	CountryCode string   `json:"CountryCode"`
+	CountryName string   `json:"CountryName"`
+	Indicator   string   `json:"Indicator"`
+	Date        Date     `json:"Date"`
+	Period      string   `json:"Period"`
+	Value       *float64 `json:"Value"`
+}
+
+type MacroService struct {
+	c RequestClient
+}
+
+func NewMacroService(c RequestClient) *MacroService {
+	return &MacroService{
+		c: c,
+	}
+}
+
+// GetIndicator returns the values of indicator for a country given by its ISO3 code.
+func (m *MacroService) GetIndicator(country string, indicator MacroIndicator) ([]*MacroValue, *Response, error) {
+	params, err := NewMacroParams(m.c.GetApiToken(), country, indicator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := params.BuildPath(m.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := m.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*MacroValue
+	res, err := m.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// CompareCountries fetches indicator for each country and pivots the values into one table.
+func (m *MacroService) CompareCountries(countries []string, indicator MacroIndicator) (*MacroTable, *Response, error) {
+	var res *Response
+	series := make(map[string][]*MacroValue, len(countries))
+	for _, country := range countries {
+		values, r, err := m.GetIndicator(country, indicator)
+		res = r
+		if err != nil {
+			return nil, res, err
+		}
+		series[country] = values
+	}
+	return PivotMacro(series), res, nil
+}
+
+// MacroTable compares an indicator across countries, one row per date.
+type MacroTable struct {
+	Countries []string
+	Rows      []*MacroTableRow
+}
+
+// MacroTableRow holds the value of each country on Date. Countries without a value are missing from Values.
+type MacroTableRow struct {
+	Date   time.Time
+	Values map[string]float64
+}
+
+// PivotMacro pivots series keyed by country into a table sorted by ascending date.
+func PivotMacro(series map[string][]*MacroValue) *MacroTable {
+	table := &MacroTable{Countries: make([]string, 0, len(series))}
+	rows := make(map[time.Time]*MacroTableRow)
+	for country, values := range series {
+		table.Countries = append(table.Countries, country)
+		for _, v := range values {
+			if v.Value == nil {
+				continue
+			}
+			row, ok := rows[v.Date.Time]
+			if !ok {
+				row = &MacroTableRow{Date: v.Date.Time, Values: make(map[string]float64)}
+				rows[v.Date.Time] = row
+			}
+			row.Values[country] = *v.Value
+		}
+	}
+	sort.Strings(table.Countries)
+
+	table.Rows = make([]*MacroTableRow, 0, len(rows))
+	for _, row := range rows {
+		table.Rows = append(table.Rows, row)
+	}
+	sort.Slice(table.Rows, func(i, j int) bool {
+		return table.Rows[i].Date.Before(table.Rows[j].Date)
+	})
+	return table
+}
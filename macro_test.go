@@ -0,0 +1,51 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestMacroParams_BuildPath(t *testing.T) {
+	p, err := NewMacroParams("test-token", "USA", MacroGdpCurrentUsd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/macro-indicator/USA?api_token=test-token&fmt=json&indicator=gdp_current_usd"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	if _, err = NewMacroParams("test-token", "USA", "gdp"); err == nil {
+		t.Error("expected error for unknown indicator")
+	}
+}
+
+func TestPivotMacro(t *testing.T) {
+	var usa, deu []*MacroValue
+	_ = json.Unmarshal([]byte(`[{"CountryCode":"USA","Date":"2022-12-31","Value":3.6},{"CountryCode":"USA","Date":"2021-12-31","Value":5.3}]`), &usa)
+	_ = json.Unmarshal([]byte(`[{"CountryCode":"DEU","Date":"2022-12-31","Value":3.1},{"CountryCode":"DEU","Date":"2021-12-31","Value":null}]`), &deu)
+
+	table := PivotMacro(map[string][]*MacroValue{"USA": usa, "DEU": deu})
+	if !reflect.DeepEqual(table.Countries, []string{"DEU", "USA"}) {
+		t.Errorf("unexpected countries %v", table.Countries)
+	}
+	if len(table.Rows) != 2 || table.Rows[0].Date.Year() != 2021 {
+		t.Fatalf("unexpected rows %v", table.Rows)
+	}
+	if _, ok := table.Rows[0].Values["DEU"]; ok {
+		t.Error("expected no DEU value for 2021")
+	}
+	if table.Rows[1].Values["DEU"] != 3.1 || table.Rows[1].Values["USA"] != 3.6 {
+		t.Errorf("unexpected 2022 row %v", table.Rows[1].Values)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"time"
+)
+
+type MarketCapParams struct {
+	Symbol      string        `url:"-"`
+	CountryCode string        `url:"-"`
+	Format      RequestFormat `url:"fmt"`
+	FromTime    *time.Time    `url:"-"`
+	ToTime      *time.Time    `url:"-"`
+	From        *string       `url:"from,omitempty"`
+	To          *string       `url:"to,omitempty"`
+	ApiToken    string        `url:"api_token"`
+}
+
+func (m *MarketCapParams) GetEncoded() (string, error) {
+	if m.FromTime != nil {
+		from := m.FromTime.Format(urlDateFormat)
+		m.From = &from
+	}
+	if m.ToTime != nil {
+		to := m.ToTime.Format(urlDateFormat)
+		m.To = &to
+	}
+	q, err := query.Values(m)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (m *MarketCapParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("historical-market-cap/%s.%s", m.Symbol, m.CountryCode)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := m.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type MarketCap struct {
+	Date  Date    `json:"date"`
+	Value float64 `json:"value"`
+}
+
+// MarketCapSeries is a market capitalization history sorted by ascending date.
+type MarketCapSeries []*MarketCap
+
+func (m *MarketCapSeries) UnmarshalJSON(b []byte) error {
+	series := make(MarketCapSeries, 0)
+	err := decodeIndexed(b, func(dec *json.Decoder) error {
+		mc := new(MarketCap)
+		if err := dec.Decode(mc); err != nil {
+			return err
+		}
+		series = append(series, mc)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Slice(series, func(i, j int) bool {
+		return series[i].Date.Before(series[j].Date.Time)
+	})
+	*m = series
+	return nil
+}
+
+type MarketCapService struct {
+	c RequestClient
+}
+
+func NewMarketCapService(c RequestClient) *MarketCapService {
+	return &MarketCapService{
+		c: c,
+	}
+}
+
+// GetMarketCap returns the weekly market capitalization history of a symbol.
+func (m *MarketCapService) GetMarketCap(symbol string, countryCode *string, from, to *time.Time) (MarketCapSeries, *Response, error) {
+	country := defaultCountryCode
+	if countryCode != nil {
+		country = *countryCode
+	}
+
+	params := &MarketCapParams{
+		ApiToken:    m.c.GetApiToken(),
+		Format:      formatJson,
+		Symbol:      symbol,
+		CountryCode: country,
+		FromTime:    from,
+		ToTime:      to,
+	}
+
+	u, err := params.BuildPath(m.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := m.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data MarketCapSeries
+	res, err := m.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// DailyMarketCap is a trading day with its market capitalization.
+// Estimated is set when no observation falls on the day itself.
+type DailyMarketCap struct {
+	Date      time.Time
+	Close     float64
+	MarketCap float64
+	Estimated bool
+}
+
+// JoinMarketCap puts a market capitalization on the dates of prices, returned in ascending date order
+// whatever the order of the input. Days between two observations are forward filled, or linearly
+// interpolated when interpolate is set, which uses the next observation and so looks ahead. Days after the
+// last observation are forward filled and days before the first one are left out.
+func JoinMarketCap(prices []*Ohlcv, caps MarketCapSeries, interpolate bool) ([]*DailyMarketCap, error) {
+	if len(caps) == 0 {
+		return nil, fmt.Errorf("no market capitalization observations")
+	}
+	caps = append(MarketCapSeries(nil), caps...)
+	sort.SliceStable(caps, func(i, j int) bool {
+		return caps[i].Date.Before(caps[j].Date.Time)
+	})
+
+	sorted := make([]*Ohlcv, len(prices))
+	copy(sorted, prices)
+	for _, p := range sorted {
+		if p.DateParsed == nil {
+			if err := p.ParseDate(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DateParsed.Before(*sorted[j].DateParsed)
+	})
+
+	daily := make([]*DailyMarketCap, 0, len(prices))
+	next := 0
+	for _, p := range sorted {
+		date := *p.DateParsed
+		for next < len(caps) && !caps[next].Date.After(date) {
+			next++
+		}
+
+		if next == 0 {
+			continue
+		}
+
+		d := &DailyMarketCap{Date: date, Close: p.Close}
+		switch {
+		case caps[next-1].Date.Equal(date):
+			d.MarketCap = caps[next-1].Value
+		case interpolate && next < len(caps):
+			prev, after := caps[next-1], caps[next]
+			span := after.Date.Sub(prev.Date.Time).Hours()
+			frac := date.Sub(prev.Date.Time).Hours() / span
+			d.MarketCap = prev.Value + (after.Value-prev.Value)*frac
+			d.Estimated = true
+		default:
+			d.MarketCap = caps[next-1].Value
+			d.Estimated = true
+		}
+		daily = append(daily, d)
+	}
+	return daily, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestMarketCapParams_BuildPath(t *testing.T) {
+	p := &MarketCapParams{
+		ApiToken:    "test-token",
+		Format:      formatJson,
+		Symbol:      "AAPL",
+		CountryCode: "US",
+		FromTime:    GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/historical-market-cap/AAPL.US?api_token=test-token&fmt=json&from=2024-01-01"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestJoinMarketCap(t *testing.T) {
+	var caps MarketCapSeries
+	body := []byte(`{"0":{"date":"2024-01-12","value":200},"1":{"date":"2024-01-05","value":100}}`)
+	if err := json.Unmarshal(body, &caps); err != nil {
+		t.Fatal(err)
+	}
+
+	// out of order, and 2024-01-04 comes before the first observation
+	prices := []*Ohlcv{{Date: "2024-01-08"}, {Date: "2024-01-04"}, {Date: "2024-01-05"}, {Date: "2024-01-15"}}
+
+	filled, err := JoinMarketCap(prices, caps, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filled) != 3 || filled[0].Date.Day() != 5 {
+		t.Fatalf("expected 3 days from 2024-01-05, got %v", filled)
+	}
+	expectedFilled := []float64{100, 100, 200}
+	for i, d := range filled {
+		if d.MarketCap != expectedFilled[i] {
+			t.Errorf("forward fill %s: expected %f, got %f", d.Date, expectedFilled[i], d.MarketCap)
+		}
+	}
+	if filled[0].Estimated || !filled[1].Estimated {
+		t.Error("expected only days without an observation to be estimated")
+	}
+
+	interpolated, err := JoinMarketCap(prices, caps, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 3 of the 7 days between the observations have passed on 2024-01-08
+	if v := interpolated[1].MarketCap; v < 142.85 || v > 142.86 {
+		t.Errorf("expected interpolated value near 142.857, got %f", v)
+	}
+}
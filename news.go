@@ -0,0 +1,230 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+const NewsMaxLimit = 1000
+
+type NewsParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Symbol   *string       `url:"s,omitempty"`
+	Tag      *string       `url:"t,omitempty"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+	Limit    int           `url:"limit"`
+	Offset   int           `url:"offset"`
+}
+
+// NewsOptions selects the articles to fetch. Either Symbol or Tag is required.
+type NewsOptions struct {
+	Symbol *string
+	Tag    *string
+	From   *time.Time
+	To     *time.Time
+}
+
+func NewNewsParams(apiToken string, opts *NewsOptions, offset, limit int) (*NewsParams, error) {
+	if opts == nil || (opts.Symbol == nil && opts.Tag == nil) {
+		return nil, errors.New("symbol or tag is required")
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > NewsMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", NewsMaxLimit)
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	return &NewsParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Symbol:   opts.Symbol,
+		Tag:      opts.Tag,
+		FromTime: opts.From,
+		ToTime:   opts.To,
+		Limit:    limit,
+		Offset:   offset,
+	}, nil
+}
+
+func (n *NewsParams) GetEncoded() (string, error) {
+	if n.FromTime != nil {
+		from := n.FromTime.Format(urlDateFormat)
+		n.From = &from
+	}
+	if n.ToTime != nil {
+		to := n.ToTime.Format(urlDateFormat)
+		n.To = &to
+	}
+	q, err := query.Values(n)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (n *NewsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("news")
+	encoded, err := n.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type NewsSentiment struct {
+	Polarity float64 `json:"polarity"`
+	Neg      float64 `json:"neg"`
+	Neu      float64 `json:"neu"`
+	Pos      float64 `json:"pos"`
+}
+
+type NewsArticle struct {
+	Date      time.Time      `json:"date"`
+	Title     string         `json:"title"`
+	Content   string         `json:"content"`
+	Link      string         `json:"link"`
+	Symbols   []string       `json:"symbols"`
+	Tags      []string       `json:"tags"`
+	Sentiment *NewsSentiment `json:"sentiment"`
+}
+
+type NewsService struct {
+	c RequestClient
+}
+
+func NewNewsService(c RequestClient) *NewsService {
+	return &NewsService{
+		c: c,
+	}
+}
+
+// GetNews fetches a single page of articles, newest first.
+func (n *NewsService) GetNews(opts *NewsOptions, offset, limit int) ([]*NewsArticle, *Response, error) {
+	params, err := NewNewsParams(n.c.GetApiToken(), opts, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return n.getPage(params)
+}
+
+func (n *NewsService) getPage(params *NewsParams) ([]*NewsArticle, *Response, error) {
+	u, err := params.BuildPath(n.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := n.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*NewsArticle
+	res, err := n.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// Iterate returns an iterator over the articles of the date window in opts.
+func (n *NewsService) Iterate(opts *NewsOptions, limit int) (*NewsIterator, error) {
+	params, err := NewNewsParams(n.c.GetApiToken(), opts, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &NewsIterator{
+		s:      n,
+		params: params,
+		seen:   make(map[string]bool),
+	}, nil
+}
+
+// NewsIterator pages through news articles. Articles already returned on an earlier page are dropped,
+// and iteration stops at the first article older than the start of the window.
+type NewsIterator struct {
+	s      *NewsService
+	params *NewsParams
+	seen   map[string]bool
+	page   []*NewsArticle
+	resp   *Response
+	err    error
+	done   bool
+}
+
+// Next fetches the next page and reports whether one is available.
+func (it *NewsIterator) Next() bool {
+	for !it.done && it.err == nil {
+		page, resp, err := it.s.getPage(it.params)
+		it.resp = resp
+		if err != nil {
+			it.err = err
+			it.page = nil
+			return false
+		}
+		if len(page) < it.params.Limit {
+			it.done = true
+		}
+		it.params.Offset += len(page)
+
+		it.page = it.filter(page)
+		if len(it.page) > 0 {
+			return true
+		}
+	}
+	it.page = nil
+	return false
+}
+
+func (it *NewsIterator) filter(page []*NewsArticle) []*NewsArticle {
+	var start time.Time
+	if it.params.FromTime != nil {
+		start = time.Date(it.params.FromTime.Year(), it.params.FromTime.Month(), it.params.FromTime.Day(), 0, 0, 0, 0, time.UTC)
+	}
+
+	articles := make([]*NewsArticle, 0, len(page))
+	for _, a := range page {
+		if !start.IsZero() && a.Date.Before(start) {
+			it.done = true
+			break
+		}
+		key := a.Link
+		if key == "" {
+			key = a.Date.String() + a.Title
+		}
+		if it.seen[key] {
+			continue
+		}
+		it.seen[key] = true
+		articles = append(articles, a)
+	}
+	return articles
+}
+
+func (it *NewsIterator) Page() []*NewsArticle {
+	return it.page
+}
+
+func (it *NewsIterator) Response() *Response {
+	return it.resp
+}
+
+func (it *NewsIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestNewsParams_BuildPath(t *testing.T) {
+	p, err := NewNewsParams("test-token", &NewsOptions{
+		Symbol: GetPtrString("AAPL.US"),
+		From:   GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		To:     GetPtrTime(time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)),
+	}, 100, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/news?api_token=test-token&fmt=json&from=2024-01-01&limit=100&offset=100&s=AAPL.US&to=2024-01-31"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	if _, err = NewNewsParams("test-token", &NewsOptions{}, 0, 0); err == nil {
+		t.Error("expected error without symbol or tag")
+	}
+}
+
+func TestNewsIterator_Filter(t *testing.T) {
+	from := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	it := &NewsIterator{
+		params: &NewsParams{FromTime: &from},
+		seen:   map[string]bool{"https://a": true},
+	}
+	page := []*NewsArticle{
+		{Link: "https://a", Date: time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC)},
+		{Link: "https://b", Date: time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC)},
+		{Link: "https://c", Date: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)},
+	}
+	articles := it.filter(page)
+	if len(articles) != 1 || articles[0].Link != "https://b" {
+		t.Errorf("expected only https://b, got %d articles", len(articles))
+	}
+	if !it.done {
+		t.Error("expected iterator to stop at the date boundary")
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"time"
+)
+
+const (
+	OptionsContractsEndpoint = "mp/unicornbay/options/contracts"
+	OptionsEodEndpoint       = "mp/unicornbay/options/eod"
+	OptionsMaxLimit          = 1000
+)
+
+const (
+	OptionCall = "call"
+	OptionPut  = "put"
+)
+
+// OptionsFilter narrows down the contracts of an options request. All fields are optional.
+type OptionsFilter struct {
+	Contract         *string
+	UnderlyingSymbol *string
+	Type             *string
+	ExpFrom          *time.Time
+	ExpTo            *time.Time
+	StrikeFrom       *float64
+	StrikeTo         *float64
+	TradeFrom        *time.Time
+	TradeTo          *time.Time
+}
+
+type OptionsParams struct {
+	ApiToken         string        `url:"api_token"`
+	Format           RequestFormat `url:"fmt"`
+	Endpoint         string        `url:"-"`
+	Contract         *string       `url:"filter[contract],omitempty"`
+	UnderlyingSymbol *string       `url:"filter[underlying_symbol],omitempty"`
+	Type             *string       `url:"filter[type],omitempty"`
+	ExpFrom          *string       `url:"filter[exp_date_from],omitempty"`
+	ExpTo            *string       `url:"filter[exp_date_to],omitempty"`
+	StrikeFrom       *float64      `url:"filter[strike_from],omitempty"`
+	StrikeTo         *float64      `url:"filter[strike_to],omitempty"`
+	TradeFrom        *string       `url:"filter[tradetime_from],omitempty"`
+	TradeTo          *string       `url:"filter[tradetime_to],omitempty"`
+	Sort             *string       `url:"sort,omitempty"`
+	Offset           int           `url:"page[offset]"`
+	Limit            int           `url:"page[limit]"`
+}
+
+func NewOptionsParams(apiToken, endpoint string, filter *OptionsFilter, offset, limit int) (*OptionsParams, error) {
+	if limit <= 0 {
+		limit = OptionsMaxLimit
+	}
+	if limit > OptionsMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", OptionsMaxLimit)
+	}
+	if offset < 0 {
+		return nil, errors.New("offset must not be negative")
+	}
+	p := &OptionsParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Endpoint: endpoint,
+		Offset:   offset,
+		Limit:    limit,
+	}
+	if filter == nil {
+		return p, nil
+	}
+	if filter.Type != nil && *filter.Type != OptionCall && *filter.Type != OptionPut {
+		return nil, fmt.Errorf("invalid option type %q", *filter.Type)
+	}
+	if filter.StrikeFrom != nil && filter.StrikeTo != nil && *filter.StrikeFrom > *filter.StrikeTo {
+		return nil, errors.New("strike from must not exceed strike to")
+	}
+	formatDate := func(t *time.Time) *string {
+		if t == nil {
+			return nil
+		}
+		return GetPtrString(t.Format(urlDateFormat))
+	}
+	p.Contract = filter.Contract
+	p.UnderlyingSymbol = filter.UnderlyingSymbol
+	p.Type = filter.Type
+	p.ExpFrom = formatDate(filter.ExpFrom)
+	p.ExpTo = formatDate(filter.ExpTo)
+	p.StrikeFrom = filter.StrikeFrom
+	p.StrikeTo = filter.StrikeTo
+	p.TradeFrom = formatDate(filter.TradeFrom)
+	p.TradeTo = formatDate(filter.TradeTo)
+	return p, nil
+}
+
+func (o *OptionsParams) GetEncoded() (string, error) {
+	q, err := query.Values(o)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (o *OptionsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(o.Endpoint)
+	encoded, err := o.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// OptionContract is the static description of an option contract.
+type OptionContract struct {
+	Contract         string  `json:"contract"`
+	UnderlyingSymbol string  `json:"underlying_symbol"`
+	ExpDate          Date    `json:"exp_date"`
+	ExpirationType   string  `json:"expiration_type"`
+	Type             string  `json:"type"`
+	Strike           float64 `json:"strike"`
+	Exchange         string  `json:"exchange"`
+	Currency         string  `json:"currency"`
+}
+
+type OptionGreeks struct {
+	Delta float64 `json:"delta"`
+	Gamma float64 `json:"gamma"`
+	Theta float64 `json:"theta"`
+	Vega  float64 `json:"vega"`
+	Rho   float64 `json:"rho"`
+}
+
+// OptionQuote is the end of day data of a contract. Volatility is the implied volatility.
+type OptionQuote struct {
+	OptionContract
+	OptionGreeks
+	TradeTime    Date    `json:"tradetime"`
+	Open         float64 `json:"open"`
+	High         float64 `json:"high"`
+	Low          float64 `json:"low"`
+	Last         float64 `json:"last"`
+	Bid          float64 `json:"bid"`
+	Ask          float64 `json:"ask"`
+	Midpoint     float64 `json:"midpoint"`
+	Volume       float64 `json:"volume"`
+	OpenInterest float64 `json:"open_interest"`
+	Volatility   float64 `json:"volatility"`
+	Theoretical  float64 `json:"theoretical"`
+	Moneyness    float64 `json:"moneyness"`
+	Dte          int     `json:"dte"`
+}
+
+type optionsResponse struct {
+	Meta struct {
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		Total  int `json:"total"`
+	} `json:"meta"`
+	Data []struct {
+		ID         string       `json:"id"`
+		Attributes *OptionQuote `json:"attributes"`
+	} `json:"data"`
+}
+
+type OptionsService struct {
+	c RequestClient
+}
+
+func NewOptionsService(c RequestClient) *OptionsService {
+	return &OptionsService{
+		c: c,
+	}
+}
+
+// GetContracts fetches a single page of contracts with their latest quote.
+func (o *OptionsService) GetContracts(filter *OptionsFilter, offset, limit int) ([]*OptionQuote, *Response, error) {
+	params, err := NewOptionsParams(o.c.GetApiToken(), OptionsContractsEndpoint, filter, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, _, res, err := o.getPage(params)
+	return data, res, err
+}
+
+// GetChain fetches every contract of underlying matching filter and groups them by expiry and strike.
+func (o *OptionsService) GetChain(underlying string, filter *OptionsFilter) (*OptionChain, *Response, error) {
+	f := OptionsFilter{}
+	if filter != nil {
+		f = *filter
+	}
+	f.UnderlyingSymbol = &underlying
+
+	params, err := NewOptionsParams(o.c.GetApiToken(), OptionsContractsEndpoint, &f, 0, OptionsMaxLimit)
+	if err != nil {
+		return nil, nil, err
+	}
+	it := o.iterate(params)
+
+	quotes := make([]*OptionQuote, 0)
+	for it.Next() {
+		quotes = append(quotes, it.Page()...)
+	}
+	if err = it.Err(); err != nil {
+		return nil, it.Response(), err
+	}
+	return BuildOptionChain(underlying, quotes), it.Response(), nil
+}
+
+// IterateContractHistory returns an iterator over the end of day history of a contract between from and to.
+func (o *OptionsService) IterateContractHistory(contract string, from, to *time.Time, limit int) (*OptionsIterator, error) {
+	params, err := NewOptionsParams(o.c.GetApiToken(), OptionsEodEndpoint, &OptionsFilter{
+		Contract:  &contract,
+		TradeFrom: from,
+		TradeTo:   to,
+	}, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	return o.iterate(params), nil
+}
+
+func (o *OptionsService) iterate(params *OptionsParams) *OptionsIterator {
+	return NewOffsetPager(params.Offset, params.Limit, 0, func(offset, limit int) ([]*OptionQuote, int, *Response, error) {
+		p := *params
+		p.Offset = offset
+		return o.getPage(&p)
+	})
+}
+
+func (o *OptionsService) getPage(params *OptionsParams) ([]*OptionQuote, int, *Response, error) {
+	u, err := params.BuildPath(o.c.GetBaseUrl())
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	req, err := o.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	var data optionsResponse
+	res, err := o.c.Do(req, &data)
+	if err != nil {
+		return nil, 0, res, err
+	}
+
+	quotes := make([]*OptionQuote, 0, len(data.Data))
+	for _, d := range data.Data {
+		if d.Attributes == nil {
+			continue
+		}
+		if d.Attributes.Contract == "" {
+			d.Attributes.Contract = d.ID
+		}
+		quotes = append(quotes, d.Attributes)
+	}
+	return quotes, data.Meta.Total, res, nil
+}
+
+// OptionsIterator walks the pages of an options request until the reported total is reached.
+type OptionsIterator = OffsetPager[*OptionQuote]
+
+// OptionChain is a snapshot of the contracts of an underlying, sorted by expiry and strike.
+type OptionChain struct {
+	Underlying string
+	Expiries   []*OptionExpiry
+}
+
+type OptionExpiry struct {
+	Date    time.Time
+	Strikes []*OptionStrike
+}
+
+// OptionStrike pairs the call and the put of a strike. Either side may be nil.
+type OptionStrike struct {
+	Strike float64
+	Call   *OptionQuote
+	Put    *OptionQuote
+}
+
+// BuildOptionChain groups quotes by expiry and strike.
+func BuildOptionChain(underlying string, quotes []*OptionQuote) *OptionChain {
+	expiries := make(map[time.Time]map[float64]*OptionStrike)
+	for _, q := range quotes {
+		strikes, ok := expiries[q.ExpDate.Time]
+		if !ok {
+			strikes = make(map[float64]*OptionStrike)
+			expiries[q.ExpDate.Time] = strikes
+		}
+		s, ok := strikes[q.Strike]
+		if !ok {
+			s = &OptionStrike{Strike: q.Strike}
+			strikes[q.Strike] = s
+		}
+		switch q.Type {
+		case OptionCall:
+			s.Call = q
+		case OptionPut:
+			s.Put = q
+		}
+	}
+
+	chain := &OptionChain{Underlying: underlying, Expiries: make([]*OptionExpiry, 0, len(expiries))}
+	for date, strikes := range expiries {
+		e := &OptionExpiry{Date: date, Strikes: make([]*OptionStrike, 0, len(strikes))}
+		for _, s := range strikes {
+			e.Strikes = append(e.Strikes, s)
+		}
+		sort.Slice(e.Strikes, func(i, j int) bool {
+			return e.Strikes[i].Strike < e.Strikes[j].Strike
+		})
+		chain.Expiries = append(chain.Expiries, e)
+	}
+	sort.Slice(chain.Expiries, func(i, j int) bool {
+		return chain.Expiries[i].Date.Before(chain.Expiries[j].Date)
+	})
+	return chain
+}
@@ -0,0 +1,118 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionsParams_BuildPath(t *testing.T) {
+	p, err := NewOptionsParams("test-token", OptionsContractsEndpoint, &OptionsFilter{
+		UnderlyingSymbol: GetPtrString("AAPL"),
+		Type:             GetPtrString(OptionCall),
+		ExpFrom:          GetPtrTime(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)),
+		StrikeFrom:       func(v float64) *float64 { return &v }(150),
+	}, 0, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/mp/unicornbay/options/contracts?api_token=test-token" +
+		"&filter%5Bexp_date_from%5D=2024-06-01&filter%5Bstrike_from%5D=150&filter%5Btype%5D=call" +
+		"&filter%5Bunderlying_symbol%5D=AAPL&fmt=json&page%5Blimit%5D=100&page%5Boffset%5D=0"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+
+	if _, err = NewOptionsParams("test-token", OptionsContractsEndpoint, &OptionsFilter{Type: GetPtrString("straddle")}, 0, 0); err == nil {
+		t.Error("expected error for invalid option type")
+	}
+}
+
+func TestBuildOptionChain(t *testing.T) {
+	body := []byte(`{"meta":{"offset":0,"limit":1000,"total":3},"data":[
+		{"id":"AAPL240621C00190000","attributes":{"contract":"AAPL240621C00190000","exp_date":"2024-06-21","type":"call","strike":190,"delta":0.55,"volatility":0.21}},
+		{"id":"AAPL240621P00190000","attributes":{"contract":"AAPL240621P00190000","exp_date":"2024-06-21","type":"put","strike":190,"delta":-0.45}},
+		{"id":"AAPL240517C00180000","attributes":{"contract":"AAPL240517C00180000","exp_date":"2024-05-17","type":"call","strike":180}}
+	]}`)
+	var resp optionsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatal(err)
+	}
+	quotes := make([]*OptionQuote, 0)
+	for _, d := range resp.Data {
+		quotes = append(quotes, d.Attributes)
+	}
+	if quotes[0].Delta != 0.55 || quotes[0].Volatility != 0.21 {
+		t.Errorf("unexpected greeks %+v", quotes[0].OptionGreeks)
+	}
+
+	chain := BuildOptionChain("AAPL", quotes)
+	if len(chain.Expiries) != 2 || chain.Expiries[0].Date.Month() != time.May {
+		t.Fatalf("unexpected expiries %v", chain.Expiries)
+	}
+	june := chain.Expiries[1].Strikes[0]
+	if june.Call == nil || june.Put == nil || june.Strike != 190 {
+		t.Errorf("expected call and put at 190, got %+v", june)
+	}
+}
+
+func TestOptionsService_GetChain(t *testing.T) {
+	contracts := []string{
+		`{"id":"AAPL240621C00190000","attributes":{"exp_date":"2024-06-21","type":"call","strike":190}}`,
+		`{"id":"AAPL240621P00190000","attributes":{"exp_date":"2024-06-21","type":"put","strike":190}}`,
+		`{"id":"AAPL240517C00180000","attributes":{"exp_date":"2024-05-17","type":"call","strike":180}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("fmt") != "json" || q.Get("filter[underlying_symbol]") != "AAPL" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		offset, _ := strconv.Atoi(q.Get("page[offset]"))
+		limit, _ := strconv.Atoi(q.Get("page[limit]"))
+		end := offset + limit
+		if end > len(contracts) {
+			end = len(contracts)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"meta":{"total":3},"data":[` + strings.Join(contracts[offset:end], ",") + `]}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	quotes, _, err := c.OptionsService.GetContracts(&OptionsFilter{UnderlyingSymbol: GetPtrString("AAPL")}, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotes) != 2 || quotes[0].Contract != "AAPL240621C00190000" {
+		t.Errorf("unexpected quotes %+v", quotes)
+	}
+
+	chain, _, err := c.OptionsService.GetChain("AAPL", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain.Expiries) != 2 || chain.Expiries[1].Strikes[0].Put == nil {
+		t.Errorf("unexpected chain %+v", chain.Expiries)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// linkPage is a JSON:API style page whose continuation is given by links.next.
+type linkPage[T any] struct {
+	Data  []T `json:"data"`
+	Links struct {
+		Next *string `json:"next"`
+	} `json:"links"`
+}
+
+// LinkPager follows the links.next URL of paginated responses until it is empty.
+type LinkPager[T any] struct {
+	c    RequestClient
+	next *url.URL
+	seen map[string]bool
+	page []T
+	resp *Response
+	err  error
+}
+
+// NewLinkPager returns a pager starting at firstUrl, typically the result of a params BuildPath.
+func NewLinkPager[T any](c RequestClient, firstUrl string) (*LinkPager[T], error) {
+	u, err := url.Parse(firstUrl)
+	if err != nil {
+		return nil, err
+	}
+	return &LinkPager[T]{
+		c:    c,
+		next: u,
+		seen: make(map[string]bool),
+	}, nil
+}
+
+// Next fetches the next page and reports whether one is available.
+func (p *LinkPager[T]) Next() bool {
+	if p.next == nil || p.err != nil {
+		return false
+	}
+
+	u := p.next.String()
+	p.next = nil
+	if p.seen[u] {
+		p.err = fmt.Errorf("eodhd: pagination loop at %s", u)
+		p.page = nil
+		return false
+	}
+	p.seen[u] = true
+
+	req, err := p.c.NewGetRequest(u, nil)
+	if err != nil {
+		p.err = err
+		p.page = nil
+		return false
+	}
+
+	var data linkPage[T]
+	p.resp, err = p.c.Do(req, &data)
+	if err != nil {
+		p.err = err
+		p.page = nil
+		return false
+	}
+
+	if data.Links.Next != nil && *data.Links.Next != "" && len(data.Data) > 0 {
+		p.next, p.err = p.resolveNext(req.URL, *data.Links.Next)
+	}
+	p.page = data.Data
+	return len(data.Data) > 0 || p.next != nil
+}
+
+// resolveNext resolves a possibly relative next link against the current request and
+// carries over the token and format, which the API leaves out of its links.
+func (p *LinkPager[T]) resolveNext(current *url.URL, next string) (*url.URL, error) {
+	ref, err := url.Parse(next)
+	if err != nil {
+		return nil, err
+	}
+	u := current.ResolveReference(ref)
+	q := u.Query()
+	cq := current.Query()
+	for _, key := range []string{"api_token", "fmt"} {
+		if q.Get(key) == "" && cq.Get(key) != "" {
+			q.Set(key, cq.Get(key))
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u, nil
+}
+
+func (p *LinkPager[T]) Page() []T {
+	return p.page
+}
+
+func (p *LinkPager[T]) Response() *Response {
+	return p.resp
+}
+
+func (p *LinkPager[T]) Err() error {
+	return p.err
+}
+
+// All drains the pager and returns every item.
+func (p *LinkPager[T]) All() ([]T, error) {
+	items := make([]T, 0)
+	for p.Next() {
+		items = append(items, p.Page()...)
+	}
+	return items, p.Err()
+}
+
+// OffsetPageFunc fetches limit items from offset. total is the size of the whole result set, or 0 when
+// the endpoint does not report it.
+type OffsetPageFunc[T any] func(offset, limit int) (page []T, total int, res *Response, err error)
+
+// OffsetPager walks offset and limit paginated results until a short or empty page, the reported total
+// or the largest offset the endpoint accepts is reached.
+//
+//	for it.Next() {
+//		page := it.Page()
+//	}
+//	if err := it.Err(); err != nil {
+//		// resume later from it.Offset()
+//	}
+type OffsetPager[T any] struct {
+	fetch     OffsetPageFunc[T]
+	offset    int
+	limit     int
+	maxOffset int
+	page      []T
+	resp      *Response
+	err       error
+	done      bool
+}
+
+// NewOffsetPager returns a pager starting at offset. A maxOffset of zero leaves the offset unbounded.
+func NewOffsetPager[T any](offset, limit, maxOffset int, fetch OffsetPageFunc[T]) *OffsetPager[T] {
+	return &OffsetPager[T]{
+		fetch:     fetch,
+		offset:    offset,
+		limit:     limit,
+		maxOffset: maxOffset,
+	}
+}
+
+// Next fetches the next page and reports whether one is available.
+func (p *OffsetPager[T]) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if p.maxOffset > 0 && p.offset > p.maxOffset {
+		p.done = true
+		p.page = nil
+		return false
+	}
+
+	page, total, resp, err := p.fetch(p.offset, p.limit)
+	p.resp = resp
+	if err != nil {
+		p.err = err
+		p.page = nil
+		return false
+	}
+	if len(page) == 0 {
+		p.done = true
+		p.page = nil
+		return false
+	}
+
+	p.page = page
+	p.offset += len(page)
+	if len(page) < p.limit || (total > 0 && p.offset >= total) {
+		p.done = true
+	}
+	return true
+}
+
+func (p *OffsetPager[T]) Page() []T {
+	return p.page
+}
+
+func (p *OffsetPager[T]) Response() *Response {
+	return p.resp
+}
+
+func (p *OffsetPager[T]) Err() error {
+	return p.err
+}
+
+// Offset returns the offset of the next page to fetch, which can be used to resume iteration.
+func (p *OffsetPager[T]) Offset() int {
+	return p.offset
+}
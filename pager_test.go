@@ -0,0 +1,55 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestOffsetPager(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	fetch := func(total int) OffsetPageFunc[int] {
+		return func(offset, limit int) ([]int, int, *Response, error) {
+			end := offset + limit
+			if end > len(items) {
+				end = len(items)
+			}
+			return items[offset:end], total, nil, nil
+		}
+	}
+	drain := func(p *OffsetPager[int]) []int {
+		got := make([]int, 0)
+		for p.Next() {
+			got = append(got, p.Page()...)
+		}
+		return got
+	}
+
+	// stops on the short last page
+	if got := drain(NewOffsetPager(0, 2, 0, fetch(0))); !reflect.DeepEqual(got, items) {
+		t.Errorf("expected %v, got %v", items, got)
+	}
+	// stops at the reported total without asking for an empty page
+	calls := 0
+	p := NewOffsetPager(0, 5, 0, func(offset, limit int) ([]int, int, *Response, error) {
+		calls++
+		return fetch(5)(offset, limit)
+	})
+	if got := drain(p); len(got) != 5 || calls != 1 {
+		t.Errorf("expected one call for 5 items, got %d calls for %v", calls, got)
+	}
+	// stops past the largest offset
+	if got := drain(NewOffsetPager(0, 2, 2, fetch(0))); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("expected [1 2 3 4], got %v", got)
+	}
+
+	failing := NewOffsetPager(2, 2, 0, func(offset, limit int) ([]int, int, *Response, error) {
+		return nil, 0, nil, errors.New("boom")
+	})
+	if failing.Next() || failing.Err() == nil || failing.Offset() != 2 {
+		t.Errorf("expected an error at offset 2, got %v at %d", failing.Err(), failing.Offset())
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-retryablehttp"
+	"sync"
+	"time"
+)
+
+var ErrQuotaExceeded = errors.New("eodhd: daily API call quota exceeded")
+
+// DefaultCallCost is the number of API calls a request spends unless its endpoint costs more.
+const DefaultCallCost = 1
+
+// Quota tracks the API calls spent against the daily limit of the subscription.
+// A DailyLimit of zero means the limit is unknown and checks always pass.
+// The used count resets when the UTC day changes, which is when the API resets its counter.
+type Quota struct {
+	mu         sync.Mutex
+	dailyLimit int
+	used       int
+	day        time.Time
+	now        func() time.Time
+}
+
+func NewQuota(dailyLimit int) *Quota {
+	q := &Quota{
+		dailyLimit: dailyLimit,
+		now:        time.Now,
+	}
+	q.day = q.today()
+	return q
+}
+
+func (q *Quota) today() time.Time {
+	return q.now().UTC().Truncate(24 * time.Hour)
+}
+
+// rollover resets the used count on the first access of a new day. The caller must hold mu.
+func (q *Quota) rollover() {
+	if today := q.today(); !today.Equal(q.day) {
+		q.day = today
+		q.used = 0
+	}
+}
+
+func (q *Quota) DailyLimit() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.dailyLimit
+}
+
+func (q *Quota) Used() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	return q.used
+}
+
+// Remaining returns the calls left for the day, or -1 when the limit is unknown.
+func (q *Quota) Remaining() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if q.dailyLimit <= 0 {
+		return -1
+	}
+	if q.used >= q.dailyLimit {
+		return 0
+	}
+	return q.dailyLimit - q.used
+}
+
+// Check returns ErrQuotaExceeded if spending cost calls would go over the daily limit.
+func (q *Quota) Check(cost int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if q.dailyLimit <= 0 || cost <= 0 {
+		return nil
+	}
+	if q.used+cost > q.dailyLimit {
+		return fmt.Errorf("%w: need %d calls, %d of %d used", ErrQuotaExceeded, cost, q.used, q.dailyLimit)
+	}
+	return nil
+}
+
+// Spend records cost calls against the quota.
+func (q *Quota) Spend(cost int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	q.used += cost
+}
+
+// Reserve spends cost calls if that stays within the daily limit, and returns ErrQuotaExceeded otherwise.
+// Unlike Check followed by Spend, concurrent callers cannot all pass the check and overshoot the limit.
+func (q *Quota) Reserve(cost int) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if q.dailyLimit > 0 && cost > 0 && q.used+cost > q.dailyLimit {
+		return fmt.Errorf("%w: need %d calls, %d of %d used", ErrQuotaExceeded, cost, q.used, q.dailyLimit)
+	}
+	q.used += cost
+	return nil
+}
+
+// Refund gives back calls reserved for a request that was not counted by the API.
+func (q *Quota) Refund(cost int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	q.used -= cost
+	if q.used < 0 {
+		q.used = 0
+	}
+}
+
+// Set replaces the limit and today's used count, e.g. with numbers reported by the server.
+func (q *Quota) Set(dailyLimit, used int) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	q.dailyLimit = dailyLimit
+	q.used = used
+}
+
+// SetAsOf is Set for a used count that was counted on day. A count from another day is stale,
+// since the API resets it at midnight UTC, and is taken as nothing used yet today.
+func (q *Quota) SetAsOf(dailyLimit, used int, day time.Time) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.rollover()
+	if !day.UTC().Truncate(24 * time.Hour).Equal(q.day) {
+		used = 0
+	}
+	q.dailyLimit = dailyLimit
+	q.used = used
+}
+
+// quotaProvider is implemented by clients that account their calls, such as Client.
+// Services use it to check the cost of a batch of requests before sending the first one.
+type quotaProvider interface {
+	GetQuota() *Quota
+}
+
+type callCostKey struct{}
+
+// withCallCost marks req as spending cost API calls. Client.Do checks and spends the quota with it.
+func withCallCost(req *retryablehttp.Request, cost int) *retryablehttp.Request {
+	return req.WithContext(context.WithValue(req.Context(), callCostKey{}, cost))
+}
+
+func callCost(ctx context.Context) int {
+	if cost, ok := ctx.Value(callCostKey{}).(int); ok {
+		return cost
+	}
+	return DefaultCallCost
+}
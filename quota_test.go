@@ -0,0 +1,127 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestQuota_Check(t *testing.T) {
+	q := NewQuota(100)
+	q.Spend(60)
+	if err := q.Check(40); err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+	if err := q.Check(41); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("expected ErrQuotaExceeded, got %v", err)
+	}
+	if q.Remaining() != 40 {
+		t.Errorf("expected 40 remaining, got %d", q.Remaining())
+	}
+
+	unknown := NewQuota(0)
+	unknown.Spend(1000)
+	if err := unknown.Check(1000); err != nil {
+		t.Errorf("expected no error for unknown limit, got %s", err)
+	}
+}
+
+func TestQuota_Rollover(t *testing.T) {
+	now := time.Date(2024, 3, 1, 23, 59, 0, 0, time.UTC)
+	q := NewQuota(100)
+	q.now = func() time.Time { return now }
+	q.day = q.today()
+
+	q.Spend(100)
+	if err := q.Check(1); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("expected ErrQuotaExceeded, got %v", err)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if err := q.Check(1); err != nil {
+		t.Errorf("expected the quota to reset on a new day, got %s", err)
+	}
+	if q.Used() != 0 {
+		t.Errorf("expected 0 used after rollover, got %d", q.Used())
+	}
+}
+
+func TestClient_DoSpendsQuota(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", SetDailyQuota(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, _, err = c.SplitsService.GetSplits("AAPL", nil, nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if c.GetQuota().Used() != 2 {
+		t.Errorf("expected 2 calls spent, got %d", c.GetQuota().Used())
+	}
+	if _, _, err = c.SplitsService.GetSplits("AAPL", nil, nil, nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("expected ErrQuotaExceeded, got %v", err)
+	}
+}
+
+func TestQuota_ReserveConcurrent(t *testing.T) {
+	q := NewQuota(50)
+	var wg sync.WaitGroup
+	var granted int32
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if q.Reserve(1) == nil {
+				atomic.AddInt32(&granted, 1)
+			}
+		}()
+	}
+	wg.Wait()
+	if granted != 50 || q.Used() != 50 {
+		t.Errorf("expected 50 reservations, got %d with %d used", granted, q.Used())
+	}
+
+	q.Refund(60)
+	if q.Used() != 0 {
+		t.Errorf("expected refunds to stop at 0, got %d", q.Used())
+	}
+}
+
+func TestClient_DoRefundsRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusPaymentRequired)
+		_, _ = w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", SetDailyQuota(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+	_, _, _ = c.SplitsService.GetSplits("AAPL", nil, nil, nil)
+	if c.GetQuota().Used() != 0 {
+		t.Errorf("expected a rejected request to be refunded, got %d used", c.GetQuota().Used())
+	}
+}
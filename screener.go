@@ -0,0 +1,331 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"strings"
+)
+
+const (
+	ScreenerMaxLimit  = 100
+	ScreenerMaxOffset = 999
+)
+
+type ScreenerField string
+
+const (
+	ScreenerCode                 ScreenerField = "code"
+	ScreenerName                 ScreenerField = "name"
+	ScreenerExchange             ScreenerField = "exchange"
+	ScreenerSector               ScreenerField = "sector"
+	ScreenerIndustry             ScreenerField = "industry"
+	ScreenerMarketCapitalization ScreenerField = "market_capitalization"
+	ScreenerEarningsShare        ScreenerField = "earnings_share"
+	ScreenerDividendYield        ScreenerField = "dividend_yield"
+	ScreenerRefund1dP            ScreenerField = "refund_1d_p"
+	ScreenerRefund5dP            ScreenerField = "refund_5d_p"
+	ScreenerAvgVol1d             ScreenerField = "avgvol_1d"
+	ScreenerAvgVol200d           ScreenerField = "avgvol_200d"
+	ScreenerAdjustedClose        ScreenerField = "adjusted_close"
+)
+
+// screenerStringFields are the fields compared as strings, every other known field is numeric.
+var screenerStringFields = map[ScreenerField]bool{
+	ScreenerCode:     true,
+	ScreenerName:     true,
+	ScreenerExchange: true,
+	ScreenerSector:   true,
+	ScreenerIndustry: true,
+}
+
+var screenerNumericFields = map[ScreenerField]bool{
+	ScreenerMarketCapitalization: true,
+	ScreenerEarningsShare:        true,
+	ScreenerDividendYield:        true,
+	ScreenerRefund1dP:            true,
+	ScreenerRefund5dP:            true,
+	ScreenerAvgVol1d:             true,
+	ScreenerAvgVol200d:           true,
+	ScreenerAdjustedClose:        true,
+}
+
+type ScreenerOperator string
+
+const (
+	ScreenerEq    ScreenerOperator = "="
+	ScreenerNotEq ScreenerOperator = "!="
+	ScreenerGt    ScreenerOperator = ">"
+	ScreenerGte   ScreenerOperator = ">="
+	ScreenerLt    ScreenerOperator = "<"
+	ScreenerLte   ScreenerOperator = "<="
+	ScreenerMatch ScreenerOperator = "match"
+)
+
+type ScreenerSignal string
+
+const (
+	Signal50dNewLow      ScreenerSignal = "50d_new_lo"
+	Signal50dNewHigh     ScreenerSignal = "50d_new_hi"
+	Signal200dNewLow     ScreenerSignal = "200d_new_lo"
+	Signal200dNewHigh    ScreenerSignal = "200d_new_hi"
+	SignalBookValueNeg   ScreenerSignal = "bookvalue_neg"
+	SignalBookValuePos   ScreenerSignal = "bookvalue_pos"
+	SignalWallStreetLow  ScreenerSignal = "wallstreet_lo"
+	SignalWallStreetHigh ScreenerSignal = "wallstreet_hi"
+)
+
+var screenerSignals = map[ScreenerSignal]bool{
+	Signal50dNewLow:      true,
+	Signal50dNewHigh:     true,
+	Signal200dNewLow:     true,
+	Signal200dNewHigh:    true,
+	SignalBookValueNeg:   true,
+	SignalBookValuePos:   true,
+	SignalWallStreetLow:  true,
+	SignalWallStreetHigh: true,
+}
+
+// ScreenerQuery builds the filters, signals and sort of a screener request.
+// The first invalid call is kept and returned by Err, later calls are ignored.
+//
+//	q := NewScreenerQuery().
+//		Filter(ScreenerMarketCapitalization, ScreenerGt, 1000000000).
+//		Filter(ScreenerSector, ScreenerEq, "Technology").
+//		Signal(Signal50dNewHigh).
+//		Sort(ScreenerMarketCapitalization, true)
+type ScreenerQuery struct {
+	filters [][]interface{}
+	signals []string
+	sort    string
+	err     error
+}
+
+func NewScreenerQuery() *ScreenerQuery {
+	return &ScreenerQuery{}
+}
+
+// Filter adds a condition. String fields accept = and match with a string value,
+// numeric fields accept the comparison operators with a numeric value.
+func (q *ScreenerQuery) Filter(field ScreenerField, op ScreenerOperator, value interface{}) *ScreenerQuery {
+	if q.err != nil {
+		return q
+	}
+	switch {
+	case screenerStringFields[field]:
+		if op != ScreenerEq && op != ScreenerMatch {
+			q.err = fmt.Errorf("operator %q is not supported for string field %s", op, field)
+			return q
+		}
+		if _, ok := value.(string); !ok {
+			q.err = fmt.Errorf("field %s requires a string value", field)
+			return q
+		}
+	case screenerNumericFields[field]:
+		switch op {
+		case ScreenerEq, ScreenerNotEq, ScreenerGt, ScreenerGte, ScreenerLt, ScreenerLte:
+		default:
+			q.err = fmt.Errorf("operator %q is not supported for numeric field %s", op, field)
+			return q
+		}
+		switch value.(type) {
+		case int, int32, int64, float32, float64:
+		default:
+			q.err = fmt.Errorf("field %s requires a numeric value", field)
+			return q
+		}
+	default:
+		q.err = fmt.Errorf("unknown screener field %q", field)
+		return q
+	}
+	q.filters = append(q.filters, []interface{}{string(field), string(op), value})
+	return q
+}
+
+func (q *ScreenerQuery) Signal(signal ScreenerSignal) *ScreenerQuery {
+	if q.err != nil {
+		return q
+	}
+	if !screenerSignals[signal] {
+		q.err = fmt.Errorf("unknown screener signal %q", signal)
+		return q
+	}
+	q.signals = append(q.signals, string(signal))
+	return q
+}
+
+func (q *ScreenerQuery) Sort(field ScreenerField, desc bool) *ScreenerQuery {
+	if q.err != nil {
+		return q
+	}
+	if !screenerStringFields[field] && !screenerNumericFields[field] {
+		q.err = fmt.Errorf("unknown screener field %q", field)
+		return q
+	}
+	dir := "asc"
+	if desc {
+		dir = "desc"
+	}
+	q.sort = fmt.Sprintf("%s.%s", field, dir)
+	return q
+}
+
+func (q *ScreenerQuery) Err() error {
+	return q.err
+}
+
+type ScreenerParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Filters  *string       `url:"filters,omitempty"`
+	Signals  *string       `url:"signals,omitempty"`
+	Sort     *string       `url:"sort,omitempty"`
+	Limit    int           `url:"limit"`
+	Offset   int           `url:"offset"`
+}
+
+func NewScreenerParams(apiToken string, q *ScreenerQuery, offset, limit int) (*ScreenerParams, error) {
+	if limit <= 0 {
+		limit = ScreenerMaxLimit
+	}
+	if limit > ScreenerMaxLimit {
+		return nil, fmt.Errorf("limit must not exceed %d", ScreenerMaxLimit)
+	}
+	if offset < 0 || offset > ScreenerMaxOffset {
+		return nil, fmt.Errorf("offset must be between 0 and %d", ScreenerMaxOffset)
+	}
+	p := &ScreenerParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Limit:    limit,
+		Offset:   offset,
+	}
+	if q == nil {
+		return p, nil
+	}
+	if q.err != nil {
+		return nil, q.err
+	}
+	if len(q.filters) > 0 {
+		// operators such as > must not be escaped to \u003e
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(q.filters); err != nil {
+			return nil, err
+		}
+		p.Filters = GetPtrString(strings.TrimSpace(buf.String()))
+	}
+	if len(q.signals) > 0 {
+		p.Signals = GetPtrString(strings.Join(q.signals, ","))
+	}
+	if q.sort != "" {
+		p.Sort = GetPtrString(q.sort)
+	}
+	return p, nil
+}
+
+func (s *ScreenerParams) GetEncoded() (string, error) {
+	q, err := query.Values(s)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (s *ScreenerParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("screener")
+	encoded, err := s.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type ScreenerResult struct {
+	Code                 string  `json:"code"`
+	Name                 string  `json:"name"`
+	LastDayDataDate      Date    `json:"last_day_data_date"`
+	AdjustedClose        float64 `json:"adjusted_close"`
+	Refund1d             float64 `json:"refund_1d"`
+	Refund1dP            float64 `json:"refund_1d_p"`
+	Refund5d             float64 `json:"refund_5d"`
+	Refund5dP            float64 `json:"refund_5d_p"`
+	Exchange             string  `json:"exchange"`
+	CurrencySymbol       string  `json:"currency_symbol"`
+	MarketCapitalization float64 `json:"market_capitalization"`
+	EarningsShare        float64 `json:"earnings_share"`
+	DividendYield        float64 `json:"dividend_yield"`
+	Sector               string  `json:"sector"`
+	Industry             string  `json:"industry"`
+	AvgVol1d             float64 `json:"avgvol_1d"`
+	AvgVol200d           float64 `json:"avgvol_200d"`
+}
+
+type screenerResponse struct {
+	Data []*ScreenerResult `json:"data"`
+}
+
+type ScreenerService struct {
+	c RequestClient
+}
+
+func NewScreenerService(c RequestClient) *ScreenerService {
+	return &ScreenerService{
+		c: c,
+	}
+}
+
+// Screen fetches a single page of screener results.
+func (s *ScreenerService) Screen(q *ScreenerQuery, offset, limit int) ([]*ScreenerResult, *Response, error) {
+	params, err := NewScreenerParams(s.c.GetApiToken(), q, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.getPage(params)
+}
+
+func (s *ScreenerService) getPage(params *ScreenerParams) ([]*ScreenerResult, *Response, error) {
+	u, err := params.BuildPath(s.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data screenerResponse
+	res, err := s.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data.Data, res, nil
+}
+
+// Iterate returns an iterator that walks the offset until the result set ends or ScreenerMaxOffset is passed.
+func (s *ScreenerService) Iterate(q *ScreenerQuery, limit int) (*ScreenerIterator, error) {
+	params, err := NewScreenerParams(s.c.GetApiToken(), q, 0, limit)
+	if err != nil {
+		return nil, err
+	}
+	return NewOffsetPager(params.Offset, params.Limit, ScreenerMaxOffset, func(offset, limit int) ([]*ScreenerResult, int, *Response, error) {
+		p := *params
+		p.Offset = offset
+		page, res, err := s.getPage(&p)
+		return page, 0, res, err
+	}), nil
+}
+
+// ScreenerIterator walks the pages of a screener query.
+type ScreenerIterator = OffsetPager[*ScreenerResult]
@@ -0,0 +1,102 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestScreenerParams_BuildPath(t *testing.T) {
+	q := NewScreenerQuery().
+		Filter(ScreenerMarketCapitalization, ScreenerGt, 1000000000).
+		Filter(ScreenerSector, ScreenerEq, "Technology").
+		Signal(Signal50dNewHigh).
+		Sort(ScreenerMarketCapitalization, true)
+	p, err := NewScreenerParams("test-token", q, 100, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	expected := "https://eodhd.com/api/screener?api_token=test-token&filters=" +
+		url.QueryEscape(`[["market_capitalization",">",1000000000],["sector","=","Technology"]]`) +
+		"&fmt=json&limit=50&offset=100&signals=50d_new_hi&sort=market_capitalization.desc"
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestScreenerQuery_Invalid(t *testing.T) {
+	invalid := []*ScreenerQuery{
+		NewScreenerQuery().Filter("pe_ratio", ScreenerGt, 10),
+		NewScreenerQuery().Filter(ScreenerSector, ScreenerGt, "Technology"),
+		NewScreenerQuery().Filter(ScreenerMarketCapitalization, ScreenerGt, "big"),
+		NewScreenerQuery().Signal("golden_cross"),
+	}
+	for i, q := range invalid {
+		if _, err := NewScreenerParams("test-token", q, 0, 0); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func TestScreenerService_Iterate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("fmt") != "json" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		w.Header().Set("Content-Type", "application/json")
+		switch offset {
+		case 0:
+			_, _ = w.Write([]byte(`{"data":[{"code":"AAPL","exchange":"US","market_capitalization":3e12},{"code":"MSFT","exchange":"US"}]}`))
+		case 2:
+			_, _ = w.Write([]byte(`{"data":[{"code":"NVDA","exchange":"US"}]}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	results, _, err := c.ScreenerService.Screen(nil, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0].Code != "AAPL" || results[0].MarketCapitalization != 3e12 {
+		t.Errorf("unexpected results %+v", results)
+	}
+
+	it, err := c.ScreenerService.Iterate(nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes := ""
+	for it.Next() {
+		for _, r := range it.Page() {
+			codes += r.Code + ","
+		}
+	}
+	if err = it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if codes != "AAPL,MSFT,NVDA," {
+		t.Errorf("unexpected codes %s", codes)
+	}
+}
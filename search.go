@@ -0,0 +1,261 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+const (
+	SearchTypeStock  = "stock"
+	SearchTypeEtf    = "etf"
+	SearchTypeFund   = "fund"
+	SearchTypeBond   = "bond"
+	SearchTypeIndex  = "index"
+	SearchTypeCrypto = "crypto"
+)
+
+// exchangeCountries maps the major EODHD exchange codes to the ISO2 code of their country.
+// It is used to tell primary listings from secondary ones.
+var exchangeCountries = map[string]string{
+	"US":    "US",
+	"LSE":   "GB",
+	"XETRA": "DE",
+	"PA":    "FR",
+	"AS":    "NL",
+	"BR":    "BE",
+	"MC":    "ES",
+	"MI":    "IT",
+	"SW":    "CH",
+	"ST":    "SE",
+	"CO":    "DK",
+	"OL":    "NO",
+	"HE":    "FI",
+	"LS":    "PT",
+	"IR":    "IE",
+	"VI":    "AT",
+	"TO":    "CA",
+	"V":     "CA",
+	"AU":    "AU",
+	"TSE":   "JP",
+	"HK":    "HK",
+	"KO":    "KR",
+	"SHG":   "CN",
+	"SHE":   "CN",
+	"NSE":   "IN",
+	"BSE":   "IN",
+	"SA":    "BR",
+	"MX":    "MX",
+	"JSE":   "ZA",
+	"TA":    "IL",
+	"WAR":   "PL",
+	"NZ":    "NZ",
+	"SG":    "SG",
+	"TW":    "TW",
+	"KLSE":  "MY",
+	"BK":    "TH",
+	"JK":    "ID",
+	"IS":    "TR",
+	"AT":    "GR",
+	"PSE":   "PH",
+	"XBOG":  "CO",
+	"SN":    "CL",
+	"BA":    "AR",
+	"LIM":   "PE",
+	"VN":    "VN",
+	"KAR":   "PK",
+	"CSE":   "LK",
+	"XNAI":  "KE",
+	"BUD":   "HU",
+	"PR":    "CZ",
+	"RO":    "RO",
+	"ZSE":   "HR",
+	"NEO":   "CA",
+	"EGX":   "EG",
+	"XNSA":  "NG",
+	"BOTSE": "BW",
+	"GSE":   "GH",
+	"MSE":   "MW",
+	"LUSE":  "ZM",
+	"USE":   "UG",
+	"DSE":   "TZ",
+	"RSE":   "RW",
+	"XZIM":  "ZW",
+	"VFEX":  "ZW",
+	"BRVM":  "CI",
+}
+
+type SearchParams struct {
+	ApiToken  string        `url:"api_token"`
+	Format    RequestFormat `url:"fmt"`
+	Query     string        `url:"-"`
+	Limit     int           `url:"limit,omitempty"`
+	BondsOnly int           `url:"bonds_only,omitempty"`
+	Type      *string       `url:"type,omitempty"`
+	Exchange  *string       `url:"exchange,omitempty"`
+}
+
+// SearchOptions holds the optional filters of a search.
+type SearchOptions struct {
+	Limit     int
+	BondsOnly bool
+	Type      *string
+	Exchange  *string
+}
+
+func NewSearchParams(apiToken, q string, opts *SearchOptions) (*SearchParams, error) {
+	if strings.TrimSpace(q) == "" {
+		return nil, errors.New("query is required")
+	}
+	p := &SearchParams{
+		ApiToken: apiToken,
+		Format:   formatJson,
+		Query:    q,
+	}
+	if opts != nil {
+		if opts.Limit < 0 || opts.Limit > 500 {
+			return nil, errors.New("limit must be between 0 and 500")
+		}
+		p.Limit = opts.Limit
+		if opts.BondsOnly {
+			p.BondsOnly = 1
+		}
+		p.Type = opts.Type
+		p.Exchange = opts.Exchange
+	}
+	return p, nil
+}
+
+func (s *SearchParams) GetEncoded() (string, error) {
+	q, err := query.Values(s)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (s *SearchParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("search/%s", s.Query)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := s.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type SearchResult struct {
+	Code              string   `json:"Code"`
+	Exchange          string   `json:"Exchange"`
+	Name              string   `json:"Name"`
+	Type              string   `json:"Type"`
+	Country           string   `json:"Country"`
+	Currency          string   `json:"Currency"`
+	ISIN              string   `json:"ISIN"`
+	PreviousClose     *float64 `json:"previousClose"`
+	PreviousCloseDate Date     `json:"previousCloseDate"`
+}
+
+// Symbol returns the result as an EODHD symbol such as AAPL.US.
+func (s *SearchResult) Symbol() string {
+	return fmt.Sprintf("%s.%s", s.Code, s.Exchange)
+}
+
+// IsPrimaryListing reports whether the listing exchange is in the country that issued the ISIN.
+func (s *SearchResult) IsPrimaryListing() bool {
+	return isPrimaryListing(s.ISIN, s.Exchange)
+}
+
+func isPrimaryListing(isin, exchange string) bool {
+	if len(isin) < 2 {
+		return false
+	}
+	country, ok := exchangeCountries[strings.ToUpper(exchange)]
+	return ok && strings.EqualFold(country, isin[:2])
+}
+
+type SearchService struct {
+	c RequestClient
+}
+
+func NewSearchService(c RequestClient) *SearchService {
+	return &SearchService{
+		c: c,
+	}
+}
+
+// Search looks up symbols by name, ticker or ISIN.
+func (s *SearchService) Search(q string, opts *SearchOptions) ([]*SearchResult, *Response, error) {
+	params, err := NewSearchParams(s.c.GetApiToken(), q, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	u, err := params.BuildPath(s.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*SearchResult
+	res, err := s.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// BestMatch searches for q and returns the most likely result, or nil if nothing was found.
+func (s *SearchService) BestMatch(q string, opts *SearchOptions) (*SearchResult, *Response, error) {
+	results, res, err := s.Search(q, opts)
+	if err != nil {
+		return nil, res, err
+	}
+	return BestSearchMatch(q, results), res, nil
+}
+
+// BestSearchMatch ranks results against q. Exact ISIN and ticker matches rank first, then primary listings,
+// then exact names. Ties keep the order returned by the API.
+func BestSearchMatch(q string, results []*SearchResult) *SearchResult {
+	if len(results) == 0 {
+		return nil
+	}
+	q = strings.TrimSpace(q)
+	score := func(r *SearchResult) int {
+		total := 0
+		if r.ISIN != "" && strings.EqualFold(r.ISIN, q) {
+			total += 8
+		}
+		if strings.EqualFold(r.Code, q) || strings.EqualFold(r.Symbol(), q) {
+			total += 4
+		}
+		if r.IsPrimaryListing() {
+			total += 2
+		}
+		if strings.EqualFold(r.Name, q) {
+			total += 1
+		}
+		return total
+	}
+
+	ranked := make([]*SearchResult, len(results))
+	copy(ranked, results)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return score(ranked[i]) > score(ranked[j])
+	})
+	return ranked[0]
+}
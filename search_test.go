@@ -0,0 +1,46 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSearchParams_BuildPath(t *testing.T) {
+	p, err := NewSearchParams("test-token", "Apple Inc", &SearchOptions{
+		Limit:    10,
+		Type:     GetPtrString(SearchTypeStock),
+		Exchange: GetPtrString("US"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/search/Apple%20Inc?api_token=test-token&exchange=US&fmt=json&limit=10&type=stock"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestBestSearchMatch(t *testing.T) {
+	results := []*SearchResult{
+		{Code: "APC", Exchange: "XETRA", Name: "Apple Inc", ISIN: "US0378331005"},
+		{Code: "AAPL", Exchange: "US", Name: "Apple Inc", ISIN: "US0378331005"},
+		{Code: "AAPL", Exchange: "MX", Name: "Apple Inc", ISIN: "US0378331005"},
+	}
+	if m := BestSearchMatch("US0378331005", results); m.Symbol() != "AAPL.US" {
+		t.Errorf("expected AAPL.US, got %s", m.Symbol())
+	}
+	if m := BestSearchMatch("apc", results); m.Symbol() != "APC.XETRA" {
+		t.Errorf("expected APC.XETRA, got %s", m.Symbol())
+	}
+	if m := BestSearchMatch("Apple", nil); m != nil {
+		t.Errorf("expected nil, got %v", m)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"time"
+)
+
+type SentimentParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Symbols  []string      `url:"s,comma"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+}
+
+func (s *SentimentParams) GetEncoded() (string, error) {
+	if s.FromTime != nil {
+		from := s.FromTime.Format(urlDateFormat)
+		s.From = &from
+	}
+	if s.ToTime != nil {
+		to := s.ToTime.Format(urlDateFormat)
+		s.To = &to
+	}
+	q, err := query.Values(s)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (s *SentimentParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("sentiments")
+	encoded, err := s.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type WordWeightsParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Symbol   string        `url:"s"`
+	DateFrom *string       `url:"filter[date_from],omitempty"`
+	DateTo   *string       `url:"filter[date_to],omitempty"`
+	Limit    int           `url:"page[limit],omitempty"`
+}
+
+func (w *WordWeightsParams) GetEncoded() (string, error) {
+	q, err := query.Values(w)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (w *WordWeightsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("news-word-weights")
+	encoded, err := w.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// Sentiment is the aggregated news sentiment of a symbol on one day.
+type Sentiment struct {
+	Date       Date    `json:"date"`
+	Count      int     `json:"count"`
+	Normalized float64 `json:"normalized"`
+}
+
+// SentimentSeries holds daily sentiment keyed by symbol, each series sorted by ascending date.
+type SentimentSeries map[string][]*Sentiment
+
+// WordWeights are the weighted words of the news about a symbol from Date to To.
+// Both are the same day in a daily series.
+type WordWeights struct {
+	Date    time.Time
+	To      time.Time
+	Weights map[string]float64
+}
+
+// WordWeightsSeries holds word weights keyed by symbol, each series sorted by ascending date.
+type WordWeightsSeries map[string][]*WordWeights
+
+// WordWeightsOptions tunes GetWordWeights. Limit is the number of top words per entry, zero for the API default.
+// Daily asks for every day separately, which costs one request per symbol and day, instead of one
+// request per symbol for the whole window.
+type WordWeightsOptions struct {
+	Limit int
+	Daily bool
+}
+
+type wordWeightsResponse struct {
+	Data map[string]float64 `json:"data"`
+}
+
+type SentimentService struct {
+	c RequestClient
+}
+
+func NewSentimentService(c RequestClient) *SentimentService {
+	return &SentimentService{
+		c: c,
+	}
+}
+
+// GetSentiments returns the daily sentiment of each symbol between from and to.
+func (s *SentimentService) GetSentiments(symbols []string, from, to *time.Time) (SentimentSeries, *Response, error) {
+	if len(symbols) == 0 {
+		return nil, nil, errors.New("at least one symbol is required")
+	}
+	params := &SentimentParams{
+		ApiToken: s.c.GetApiToken(),
+		Format:   formatJson,
+		Symbols:  symbols,
+		FromTime: from,
+		ToTime:   to,
+	}
+
+	u, err := params.BuildPath(s.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data SentimentSeries
+	res, err := s.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	for _, series := range data {
+		sort.Slice(series, func(i, j int) bool {
+			return series[i].Date.Before(series[j].Date.Time)
+		})
+	}
+	return data, res, nil
+}
+
+// GetWordWeights returns the word weights of the news about each symbol between from and to.
+// By default each symbol gets a single entry covering the window. With opts.Daily the series has one entry
+// per day, at the cost of len(symbols) requests per day, which are checked against the quota up front.
+func (s *SentimentService) GetWordWeights(symbols []string, from, to time.Time, opts *WordWeightsOptions) (WordWeightsSeries, *Response, error) {
+	if len(symbols) == 0 {
+		return nil, nil, errors.New("at least one symbol is required")
+	}
+	if to.Before(from) {
+		return nil, nil, errors.New("to must not be before from")
+	}
+	if opts == nil {
+		opts = &WordWeightsOptions{}
+	}
+
+	windows := [][2]time.Time{{from, to}}
+	if opts.Daily {
+		windows = splitDateWindow(from, to, 1)
+		if q, ok := s.c.(quotaProvider); ok {
+			if err := q.GetQuota().Check(len(symbols) * len(windows)); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	var res *Response
+	data := make(WordWeightsSeries, len(symbols))
+	for _, symbol := range symbols {
+		for _, w := range windows {
+			params := &WordWeightsParams{
+				ApiToken: s.c.GetApiToken(),
+				Format:   formatJson,
+				Symbol:   symbol,
+				DateFrom: GetPtrString(w[0].Format(urlDateFormat)),
+				DateTo:   GetPtrString(w[1].Format(urlDateFormat)),
+				Limit:    opts.Limit,
+			}
+
+			u, err := params.BuildPath(s.c.GetBaseUrl())
+			if err != nil {
+				return nil, res, err
+			}
+
+			req, err := s.c.NewGetRequest(u, nil)
+			if err != nil {
+				return nil, res, err
+			}
+
+			var ww wordWeightsResponse
+			res, err = s.c.Do(req, &ww)
+			if err != nil {
+				return nil, res, err
+			}
+			data[symbol] = append(data[symbol], &WordWeights{Date: w[0], To: w[1], Weights: ww.Data})
+		}
+	}
+	return data, res, nil
+}
+
+// AlignedSentiment is a trading day with its close, the sentiment of that day
+// and the return from this close to the next one.
+type AlignedSentiment struct {
+	Date          time.Time
+	Close         float64
+	Sentiment     *Sentiment
+	NextDayReturn *float64
+}
+
+// AlignSentiment indexes sentiment by the dates of prices. Days without sentiment have a nil Sentiment
+// and the last day has a nil NextDayReturn.
+func AlignSentiment(prices []*Ohlcv, sentiment []*Sentiment) ([]*AlignedSentiment, error) {
+	byDate := make(map[string]*Sentiment, len(sentiment))
+	for _, s := range sentiment {
+		byDate[s.Date.String()] = s
+	}
+
+	aligned := make([]*AlignedSentiment, len(prices))
+	for i, p := range prices {
+		if p.DateParsed == nil {
+			if err := p.ParseDate(); err != nil {
+				return nil, err
+			}
+		}
+		aligned[i] = &AlignedSentiment{
+			Date:      *p.DateParsed,
+			Close:     p.AdjClose,
+			Sentiment: byDate[p.DateParsed.Format(urlDateFormat)],
+		}
+		if i > 0 && aligned[i-1].Close != 0 {
+			r := aligned[i].Close/aligned[i-1].Close - 1
+			aligned[i-1].NextDayReturn = &r
+		}
+	}
+	return aligned, nil
+}
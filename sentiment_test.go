@@ -0,0 +1,102 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSentimentParams_BuildPath(t *testing.T) {
+	p := &SentimentParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		Symbols:  []string{"AAPL.US", "MSFT.US"},
+		FromTime: GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/sentiments?api_token=test-token&fmt=json&from=2024-01-01&s=AAPL.US%2CMSFT.US"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestAlignSentiment(t *testing.T) {
+	var series SentimentSeries
+	body := []byte(`{"AAPL.US":[{"date":"2024-01-03","count":4,"normalized":-0.2},{"date":"2024-01-02","count":12,"normalized":0.6}]}`)
+	if err := json.Unmarshal(body, &series); err != nil {
+		t.Fatal(err)
+	}
+
+	prices := []*Ohlcv{
+		{Date: "2024-01-02", AdjClose: 100},
+		{Date: "2024-01-03", AdjClose: 102},
+		{Date: "2024-01-04", AdjClose: 101},
+	}
+	aligned, err := AlignSentiment(prices, series["AAPL.US"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aligned[0].Sentiment == nil || aligned[0].Sentiment.Normalized != 0.6 {
+		t.Errorf("unexpected sentiment %v", aligned[0].Sentiment)
+	}
+	if aligned[0].NextDayReturn == nil || math.Abs(*aligned[0].NextDayReturn-0.02) > 1e-9 {
+		t.Errorf("unexpected next day return %v", aligned[0].NextDayReturn)
+	}
+	if aligned[2].Sentiment != nil || aligned[2].NextDayReturn != nil {
+		t.Error("expected no sentiment and no next day return on the last day")
+	}
+}
+
+func TestSentimentService_GetWordWeights(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"iphone":0.5,"` + r.URL.Query().Get("s") + `":0.2}}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClient("test-token", SetDailyQuota(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = c.setBaseUrl(server.URL + "/api"); err != nil {
+		t.Fatal(err)
+	}
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC)
+	series, _, err := c.SentimentService.GetWordWeights([]string{"AAPL.US", "MSFT.US"}, from, to, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 || len(series["AAPL.US"]) != 1 || !series["AAPL.US"][0].To.Equal(to) || series["MSFT.US"][0].Weights["MSFT.US"] != 0.2 {
+		t.Errorf("unexpected series %v after %d calls", series, calls)
+	}
+
+	// 2 symbols over 3 days need 6 calls, more than the 3 left
+	_, _, err = c.SentimentService.GetWordWeights([]string{"AAPL.US", "MSFT.US"}, from, to, &WordWeightsOptions{Daily: true})
+	if !errors.Is(err, ErrQuotaExceeded) || calls != 2 {
+		t.Errorf("expected ErrQuotaExceeded before any call, got %v after %d calls", err, calls)
+	}
+
+	series, _, err = c.SentimentService.GetWordWeights([]string{"AAPL.US"}, from, to, &WordWeightsOptions{Daily: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(series["AAPL.US"]) != 3 || series["AAPL.US"][2].Date.Day() != 3 {
+		t.Errorf("expected 3 daily entries, got %v", series["AAPL.US"])
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type SplitsParams struct {
+	Symbol      string        `url:"-"`
+	CountryCode string        `url:"-"`
+	Format      RequestFormat `url:"fmt"`
+	FromTime    *time.Time    `url:"-"`
+	ToTime      *time.Time    `url:"-"`
+	From        *string       `url:"from,omitempty"`
+	To          *string       `url:"to,omitempty"`
+	ApiToken    string        `url:"api_token"`
+}
+
+func (s *SplitsParams) GetEncoded() (string, error) {
+	if s.FromTime != nil {
+		from := s.FromTime.Format(urlDateFormat)
+		s.From = &from
+	}
+	if s.ToTime != nil {
+		to := s.ToTime.Format(urlDateFormat)
+		s.To = &to
+	}
+	q, err := query.Values(s)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (s *SplitsParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("splits/%s.%s", s.Symbol, s.CountryCode)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := s.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// Split is a stock split. A 4 for 1 split has a Numerator of 4, a Denominator of 1 and a Factor of 4.
+type Split struct {
+	Date        time.Time
+	Numerator   float64
+	Denominator float64
+	Factor      float64
+}
+
+type splitJson struct {
+	Date  string `json:"date"`
+	Split string `json:"split"`
+}
+
+func (s *Split) UnmarshalJSON(b []byte) error {
+	var raw splitJson
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	date, err := time.Parse(urlDateFormat, raw.Date)
+	if err != nil {
+		return err
+	}
+	num, den, err := ParseSplitRatio(raw.Split)
+	if err != nil {
+		return err
+	}
+	s.Date = date
+	s.Numerator = num
+	s.Denominator = den
+	s.Factor = num / den
+	return nil
+}
+
+// ParseSplitRatio parses a ratio such as "4.000000/1.000000" into its numerator and denominator.
+func ParseSplitRatio(ratio string) (float64, float64, error) {
+	parts := strings.Split(ratio, "/")
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("invalid split ratio %q", ratio)
+	}
+	num, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid split ratio %q: %w", ratio, err)
+	}
+	den, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid split ratio %q: %w", ratio, err)
+	}
+	if num <= 0 || den <= 0 {
+		return 0, 0, fmt.Errorf("invalid split ratio %q: values must be positive", ratio)
+	}
+	return num, den, nil
+}
+
+type SplitsService struct {
+	c RequestClient
+}
+
+func NewSplitsService(c RequestClient) *SplitsService {
+	return &SplitsService{
+		c: c,
+	}
+}
+
+func (s *SplitsService) GetSplits(symbol string, countryCode *string, from, to *time.Time) ([]*Split, *Response, error) {
+	country := defaultCountryCode
+	if countryCode != nil {
+		country = *countryCode
+	}
+
+	params := &SplitsParams{
+		ApiToken:    s.c.GetApiToken(),
+		Format:      formatJson,
+		Symbol:      symbol,
+		CountryCode: country,
+		FromTime:    from,
+		ToTime:      to,
+	}
+
+	u, err := params.BuildPath(s.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*Split
+	res, err := s.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// CumulativeSplitFactor returns the product of the factors of all splits taking effect after date.
+// A raw price on date is split adjusted by dividing it by this factor, and a raw volume by multiplying.
+func CumulativeSplitFactor(splits []*Split, date time.Time) float64 {
+	factor := 1.0
+	for _, s := range splits {
+		if s.Date.After(date) {
+			factor *= s.Factor
+		}
+	}
+	return factor
+}
@@ -0,0 +1,53 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestSplitsParams_BuildPath(t *testing.T) {
+	p := &SplitsParams{
+		ApiToken:    "test-token",
+		Format:      formatJson,
+		Symbol:      "AAPL",
+		CountryCode: "US",
+		FromTime:    GetPtrTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/splits/AAPL.US?api_token=test-token&fmt=json&from=2000-01-01"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestCumulativeSplitFactor(t *testing.T) {
+	body := []byte(`[{"date":"2014-06-09","split":"7.000000/1.000000"},{"date":"2020-08-31","split":"4.000000/1.000000"}]`)
+	var splits []*Split
+	if err := json.Unmarshal(body, &splits); err != nil {
+		t.Fatal(err)
+	}
+	if splits[1].Numerator != 4 || splits[1].Denominator != 1 || splits[1].Factor != 4 {
+		t.Errorf("unexpected split %+v", splits[1])
+	}
+
+	cases := map[string]float64{
+		"2010-01-04": 28,
+		"2014-06-09": 4,
+		"2020-08-31": 1,
+	}
+	for d, expected := range cases {
+		date, _ := time.Parse(urlDateFormat, d)
+		if f := CumulativeSplitFactor(splits, date); f != expected {
+			t.Errorf("%s: expected %f, got %f", d, expected, f)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+type SymbolChangeParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	FromTime *time.Time    `url:"-"`
+	ToTime   *time.Time    `url:"-"`
+	From     *string       `url:"from,omitempty"`
+	To       *string       `url:"to,omitempty"`
+}
+
+func (s *SymbolChangeParams) GetEncoded() (string, error) {
+	if s.FromTime != nil {
+		from := s.FromTime.Format(urlDateFormat)
+		s.From = &from
+	}
+	if s.ToTime != nil {
+		to := s.ToTime.Format(urlDateFormat)
+		s.To = &to
+	}
+	q, err := query.Values(s)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (s *SymbolChangeParams) BuildPath(baseUrl *url.URL) (string, error) {
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath("symbol-change-history")
+	encoded, err := s.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+type SymbolChange struct {
+	Date     Date   `json:"effective"`
+	Old      string `json:"old_symbol"`
+	New      string `json:"new_symbol"`
+	Exchange string `json:"exchange"`
+	Company  string `json:"company_name"`
+}
+
+type SymbolChangeService struct {
+	c RequestClient
+}
+
+func NewSymbolChangeService(c RequestClient) *SymbolChangeService {
+	return &SymbolChangeService{
+		c: c,
+	}
+}
+
+func (s *SymbolChangeService) GetSymbolChanges(from, to *time.Time) ([]*SymbolChange, *Response, error) {
+	params := &SymbolChangeParams{
+		ApiToken: s.c.GetApiToken(),
+		Format:   formatJson,
+		FromTime: from,
+		ToTime:   to,
+	}
+
+	u, err := params.BuildPath(s.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := s.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data []*SymbolChange
+	res, err := s.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data, res, nil
+}
+
+// SymbolResolver follows ticker renames forwards and backwards in time.
+// Changes are tracked per exchange since codes are only unique within one.
+type SymbolResolver struct {
+	changes []*SymbolChange
+}
+
+func NewSymbolResolver(changes []*SymbolChange) *SymbolResolver {
+	sorted := make([]*SymbolChange, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Date.Before(sorted[j].Date.Time)
+	})
+	return &SymbolResolver{changes: sorted}
+}
+
+// Current returns the code that ticker was renamed to by asOf, or ticker itself if it was not renamed.
+func (r *SymbolResolver) Current(exchange, ticker string, asOf time.Time) string {
+	code := ticker
+	for _, c := range r.changes {
+		if c.Date.After(asOf) {
+			break
+		}
+		if !strings.EqualFold(c.Exchange, exchange) || !strings.EqualFold(c.Old, code) {
+			continue
+		}
+		code = c.New
+	}
+	return code
+}
+
+// History returns the earlier codes of code as of asOf, oldest first.
+func (r *SymbolResolver) History(exchange, code string, asOf time.Time) []string {
+	history := make([]string, 0)
+	current := code
+	until := asOf
+	for i := len(r.changes) - 1; i >= 0; i-- {
+		c := r.changes[i]
+		if c.Date.After(until) || !strings.EqualFold(c.Exchange, exchange) || !strings.EqualFold(c.New, current) {
+			continue
+		}
+		history = append([]string{c.Old}, history...)
+		current = c.Old
+		until = c.Date.Time
+	}
+	return history
+}
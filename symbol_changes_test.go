@@ -0,0 +1,60 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSymbolChangeParams_BuildPath(t *testing.T) {
+	p := &SymbolChangeParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		FromTime: GetPtrTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		ToTime:   GetPtrTime(time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC)),
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/symbol-change-history?api_token=test-token&fmt=json&from=2022-01-01&to=2022-12-31"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestSymbolResolver(t *testing.T) {
+	body := []byte(`[
+		{"exchange":"US","old_symbol":"FB","new_symbol":"META","company_name":"Meta Platforms Inc","effective":"2022-06-09"},
+		{"exchange":"US","old_symbol":"ABC","new_symbol":"DEF","company_name":"Example Corp","effective":"2015-03-02"},
+		{"exchange":"US","old_symbol":"DEF","new_symbol":"GHI","company_name":"Example Corp","effective":"2019-07-01"}
+	]`)
+	var changes []*SymbolChange
+	if err := json.Unmarshal(body, &changes); err != nil {
+		t.Fatal(err)
+	}
+	r := NewSymbolResolver(changes)
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if c := r.Current("US", "FB", now); c != "META" {
+		t.Errorf("expected META, got %s", c)
+	}
+	if c := r.Current("US", "FB", time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)); c != "FB" {
+		t.Errorf("expected FB before the rename, got %s", c)
+	}
+	if c := r.Current("US", "ABC", now); c != "GHI" {
+		t.Errorf("expected GHI, got %s", c)
+	}
+	if h := r.History("US", "GHI", now); !reflect.DeepEqual(h, []string{"ABC", "DEF"}) {
+		t.Errorf("expected [ABC DEF], got %v", h)
+	}
+	if h := r.History("US", "GHI", time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)); len(h) != 0 {
+		t.Errorf("expected no history before GHI existed, got %v", h)
+	}
+}
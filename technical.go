@@ -0,0 +1,502 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"net/url"
+	"time"
+)
+
+const (
+	TechnicalSma           = "sma"
+	TechnicalEma           = "ema"
+	TechnicalWma           = "wma"
+	TechnicalVolatility    = "volatility"
+	TechnicalStochastic    = "stochastic"
+	TechnicalRsi           = "rsi"
+	TechnicalStdDev        = "stddev"
+	TechnicalStochRsi      = "stochrsi"
+	TechnicalSlope         = "slope"
+	TechnicalDmi           = "dmi"
+	TechnicalAdx           = "adx"
+	TechnicalMacd          = "macd"
+	TechnicalAtr           = "atr"
+	TechnicalCci           = "cci"
+	TechnicalSar           = "sar"
+	TechnicalBeta          = "beta"
+	TechnicalBbands        = "bbands"
+	TechnicalAvgVol        = "avgvol"
+	TechnicalAvgVolCcy     = "avgvolccy"
+	TechnicalSplitAdjusted = "splitadjusted"
+)
+
+const (
+	TechnicalOrderAsc  = "a"
+	TechnicalOrderDesc = "d"
+)
+
+const (
+	minTechnicalPeriod = 2
+	maxTechnicalPeriod = 100000
+)
+
+// TechnicalRequest is the function specific part of a technical indicator request.
+// Implementations carry url tags for their own parameters.
+type TechnicalRequest interface {
+	Function() string
+	Validate() error
+}
+
+func validatePeriod(name string, period int) error {
+	if period == 0 {
+		// the API default is used
+		return nil
+	}
+	if period < minTechnicalPeriod || period > maxTechnicalPeriod {
+		return fmt.Errorf("%s must be between %d and %d", name, minTechnicalPeriod, maxTechnicalPeriod)
+	}
+	return nil
+}
+
+// PeriodRequest holds the period shared by the single period indicators.
+type PeriodRequest struct {
+	Period int `url:"period,omitempty"`
+}
+
+func (p PeriodRequest) Validate() error {
+	return validatePeriod("period", p.Period)
+}
+
+type SmaRequest struct{ PeriodRequest }
+
+func (SmaRequest) Function() string { return TechnicalSma }
+
+type EmaRequest struct{ PeriodRequest }
+
+func (EmaRequest) Function() string { return TechnicalEma }
+
+type WmaRequest struct{ PeriodRequest }
+
+func (WmaRequest) Function() string { return TechnicalWma }
+
+type VolatilityRequest struct{ PeriodRequest }
+
+func (VolatilityRequest) Function() string { return TechnicalVolatility }
+
+type RsiRequest struct{ PeriodRequest }
+
+func (RsiRequest) Function() string { return TechnicalRsi }
+
+type StdDevRequest struct{ PeriodRequest }
+
+func (StdDevRequest) Function() string { return TechnicalStdDev }
+
+type SlopeRequest struct{ PeriodRequest }
+
+func (SlopeRequest) Function() string { return TechnicalSlope }
+
+type DmiRequest struct{ PeriodRequest }
+
+func (DmiRequest) Function() string { return TechnicalDmi }
+
+type AdxRequest struct{ PeriodRequest }
+
+func (AdxRequest) Function() string { return TechnicalAdx }
+
+type AtrRequest struct{ PeriodRequest }
+
+func (AtrRequest) Function() string { return TechnicalAtr }
+
+type CciRequest struct{ PeriodRequest }
+
+func (CciRequest) Function() string { return TechnicalCci }
+
+type BbandsRequest struct{ PeriodRequest }
+
+func (BbandsRequest) Function() string { return TechnicalBbands }
+
+type AvgVolRequest struct{ PeriodRequest }
+
+func (AvgVolRequest) Function() string { return TechnicalAvgVol }
+
+type AvgVolCcyRequest struct{ PeriodRequest }
+
+func (AvgVolCcyRequest) Function() string { return TechnicalAvgVolCcy }
+
+type MacdRequest struct {
+	FastPeriod   int `url:"fast_period,omitempty"`
+	SlowPeriod   int `url:"slow_period,omitempty"`
+	SignalPeriod int `url:"signal_period,omitempty"`
+}
+
+func (MacdRequest) Function() string { return TechnicalMacd }
+
+func (m MacdRequest) Validate() error {
+	if err := validatePeriod("fast_period", m.FastPeriod); err != nil {
+		return err
+	}
+	if err := validatePeriod("slow_period", m.SlowPeriod); err != nil {
+		return err
+	}
+	if err := validatePeriod("signal_period", m.SignalPeriod); err != nil {
+		return err
+	}
+	if m.FastPeriod != 0 && m.SlowPeriod != 0 && m.FastPeriod >= m.SlowPeriod {
+		return errors.New("fast_period must be less than slow_period")
+	}
+	return nil
+}
+
+type StochasticRequest struct {
+	FastKPeriod int `url:"fast_kperiod,omitempty"`
+	SlowKPeriod int `url:"slow_kperiod,omitempty"`
+	SlowDPeriod int `url:"slow_dperiod,omitempty"`
+}
+
+func (StochasticRequest) Function() string { return TechnicalStochastic }
+
+func (s StochasticRequest) Validate() error {
+	if err := validatePeriod("fast_kperiod", s.FastKPeriod); err != nil {
+		return err
+	}
+	if err := validatePeriod("slow_kperiod", s.SlowKPeriod); err != nil {
+		return err
+	}
+	return validatePeriod("slow_dperiod", s.SlowDPeriod)
+}
+
+type StochRsiRequest struct {
+	FastKPeriod int `url:"fast_kperiod,omitempty"`
+	FastDPeriod int `url:"fast_dperiod,omitempty"`
+}
+
+func (StochRsiRequest) Function() string { return TechnicalStochRsi }
+
+func (s StochRsiRequest) Validate() error {
+	if err := validatePeriod("fast_kperiod", s.FastKPeriod); err != nil {
+		return err
+	}
+	return validatePeriod("fast_dperiod", s.FastDPeriod)
+}
+
+type SarRequest struct {
+	Acceleration float64 `url:"acceleration,omitempty"`
+	Maximum      float64 `url:"maximum,omitempty"`
+}
+
+func (SarRequest) Function() string { return TechnicalSar }
+
+func (s SarRequest) Validate() error {
+	if s.Acceleration < 0 || s.Maximum < 0 {
+		return errors.New("acceleration and maximum must not be negative")
+	}
+	if s.Acceleration != 0 && s.Maximum != 0 && s.Acceleration > s.Maximum {
+		return errors.New("acceleration must not exceed maximum")
+	}
+	return nil
+}
+
+// BetaRequest compares the symbol against Code2, which defaults to GSPC.INDX on the API side.
+type BetaRequest struct {
+	PeriodRequest
+	Code2 string `url:"code2,omitempty"`
+}
+
+func (BetaRequest) Function() string { return TechnicalBeta }
+
+const (
+	AggregateDaily   = "d"
+	AggregateWeekly  = "w"
+	AggregateMonthly = "m"
+)
+
+type SplitAdjustedRequest struct {
+	AggregatePeriod string `url:"agg_period,omitempty"`
+}
+
+func (SplitAdjustedRequest) Function() string { return TechnicalSplitAdjusted }
+
+func (s SplitAdjustedRequest) Validate() error {
+	switch s.AggregatePeriod {
+	case "", AggregateDaily, AggregateWeekly, AggregateMonthly:
+		return nil
+	}
+	return fmt.Errorf("invalid agg_period %q", s.AggregatePeriod)
+}
+
+// TechnicalOptions holds the parameters shared by all indicator functions.
+type TechnicalOptions struct {
+	From              *time.Time
+	To                *time.Time
+	Order             *string
+	SplitAdjustedOnly bool
+}
+
+type TechnicalParams struct {
+	ApiToken          string           `url:"api_token"`
+	Format            RequestFormat    `url:"fmt"`
+	Symbol            string           `url:"-"`
+	CountryCode       string           `url:"-"`
+	Function          string           `url:"function"`
+	Request           TechnicalRequest `url:"-"`
+	From              *string          `url:"from,omitempty"`
+	To                *string          `url:"to,omitempty"`
+	Order             *string          `url:"order,omitempty"`
+	SplitAdjustedOnly int              `url:"splitadjusted_only,omitempty"`
+}
+
+func NewTechnicalParams(apiToken, symbol, countryCode string, req TechnicalRequest, opts *TechnicalOptions) (*TechnicalParams, error) {
+	if req == nil {
+		return nil, errors.New("technical request is required")
+	}
+	if err := req.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", req.Function(), err)
+	}
+	p := &TechnicalParams{
+		ApiToken:    apiToken,
+		Format:      formatJson,
+		Symbol:      symbol,
+		CountryCode: countryCode,
+		Function:    req.Function(),
+		Request:     req,
+	}
+	if opts != nil {
+		if opts.From != nil {
+			p.From = GetPtrString(opts.From.Format(urlDateFormat))
+		}
+		if opts.To != nil {
+			p.To = GetPtrString(opts.To.Format(urlDateFormat))
+		}
+		if opts.Order != nil && *opts.Order != TechnicalOrderAsc && *opts.Order != TechnicalOrderDesc {
+			return nil, fmt.Errorf("invalid order %q", *opts.Order)
+		}
+		p.Order = opts.Order
+		if opts.SplitAdjustedOnly {
+			p.SplitAdjustedOnly = 1
+		}
+	}
+	return p, nil
+}
+
+func (t *TechnicalParams) GetEncoded() (string, error) {
+	q, err := query.Values(t)
+	if err != nil {
+		return "", err
+	}
+	fq, err := query.Values(t.Request)
+	if err != nil {
+		return "", err
+	}
+	for k, v := range fq {
+		q[k] = v
+	}
+	return q.Encode(), nil
+}
+
+func (t *TechnicalParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("technical/%s.%s", t.Symbol, t.CountryCode)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := t.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// TechnicalValue is a row of a single output indicator such as sma, rsi or atr.
+// The value is read from whichever field other than date the row holds.
+type TechnicalValue struct {
+	Date  Date
+	Value float64
+}
+
+func (t *TechnicalValue) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for k, v := range raw {
+		if k == "date" {
+			if err := json.Unmarshal(v, &t.Date); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := json.Unmarshal(v, &t.Value); err != nil {
+			return fmt.Errorf("technical value %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+type MacdRow struct {
+	Date       Date    `json:"date"`
+	Macd       float64 `json:"macd"`
+	Signal     float64 `json:"signal"`
+	Divergence float64 `json:"divergence"`
+}
+
+type StochasticRow struct {
+	Date Date    `json:"date"`
+	K    float64 `json:"k_values"`
+	D    float64 `json:"d_values"`
+}
+
+type StochRsiRow struct {
+	Date  Date    `json:"date"`
+	FastK float64 `json:"fast_k_line"`
+	FastD float64 `json:"fast_d_line"`
+}
+
+type BbandsRow struct {
+	Date   Date    `json:"date"`
+	Upper  float64 `json:"uband"`
+	Middle float64 `json:"mband"`
+	Lower  float64 `json:"lband"`
+}
+
+type DmiRow struct {
+	Date    Date    `json:"date"`
+	PlusDI  float64 `json:"plus_di"`
+	MinusDI float64 `json:"minus_di"`
+}
+
+type SplitAdjustedRow struct {
+	Date   Date    `json:"date"`
+	Open   float64 `json:"open"`
+	High   float64 `json:"high"`
+	Low    float64 `json:"low"`
+	Close  float64 `json:"close"`
+	Volume float64 `json:"volume"`
+}
+
+type TechnicalService struct {
+	c RequestClient
+}
+
+func NewTechnicalService(c RequestClient) *TechnicalService {
+	return &TechnicalService{
+		c: c,
+	}
+}
+
+// GetValues runs a single output indicator: sma, ema, wma, volatility, rsi, stddev, slope, adx, atr, cci, sar,
+// beta, avgvol or avgvolccy.
+func (t *TechnicalService) GetValues(symbol string, countryCode *string, req TechnicalRequest, opts *TechnicalOptions) ([]*TechnicalValue, *Response, error) {
+	if req != nil {
+		switch req.Function() {
+		case TechnicalMacd, TechnicalStochastic, TechnicalStochRsi, TechnicalBbands, TechnicalDmi, TechnicalSplitAdjusted:
+			return nil, nil, fmt.Errorf("%s returns several outputs and has its own method", req.Function())
+		}
+	}
+	var data []*TechnicalValue
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetMacd(symbol string, countryCode *string, req *MacdRequest, opts *TechnicalOptions) ([]*MacdRow, *Response, error) {
+	if req == nil {
+		req = &MacdRequest{}
+	}
+	var data []*MacdRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetStochastic(symbol string, countryCode *string, req *StochasticRequest, opts *TechnicalOptions) ([]*StochasticRow, *Response, error) {
+	if req == nil {
+		req = &StochasticRequest{}
+	}
+	var data []*StochasticRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetStochRsi(symbol string, countryCode *string, req *StochRsiRequest, opts *TechnicalOptions) ([]*StochRsiRow, *Response, error) {
+	if req == nil {
+		req = &StochRsiRequest{}
+	}
+	var data []*StochRsiRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetBbands(symbol string, countryCode *string, req *BbandsRequest, opts *TechnicalOptions) ([]*BbandsRow, *Response, error) {
+	if req == nil {
+		req = &BbandsRequest{}
+	}
+	var data []*BbandsRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetDmi(symbol string, countryCode *string, req *DmiRequest, opts *TechnicalOptions) ([]*DmiRow, *Response, error) {
+	if req == nil {
+		req = &DmiRequest{}
+	}
+	var data []*DmiRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) GetSplitAdjusted(symbol string, countryCode *string, req *SplitAdjustedRequest, opts *TechnicalOptions) ([]*SplitAdjustedRow, *Response, error) {
+	if req == nil {
+		req = &SplitAdjustedRequest{}
+	}
+	var data []*SplitAdjustedRow
+	res, err := t.get(symbol, countryCode, req, opts, &data)
+	if err != nil {
+		return nil, res, err
+	}
+	return data, res, nil
+}
+
+func (t *TechnicalService) get(symbol string, countryCode *string, tr TechnicalRequest, opts *TechnicalOptions, data interface{}) (*Response, error) {
+	country := defaultCountryCode
+	if countryCode != nil {
+		country = *countryCode
+	}
+
+	params, err := NewTechnicalParams(t.c.GetApiToken(), symbol, country, tr, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := params.BuildPath(t.c.GetBaseUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := t.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.c.Do(req, data)
+}
@@ -0,0 +1,57 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTechnicalParams_BuildPath(t *testing.T) {
+	p, err := NewTechnicalParams("test-token", "AAPL", "US", &MacdRequest{FastPeriod: 12, SlowPeriod: 26, SignalPeriod: 9}, &TechnicalOptions{
+		From:  GetPtrTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+		Order: GetPtrString(TechnicalOrderDesc),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/technical/AAPL.US?api_token=test-token&fast_period=12&fmt=json&from=2024-01-01&function=macd&order=d&signal_period=9&slow_period=26"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestTechnicalRequest_Validate(t *testing.T) {
+	invalid := []TechnicalRequest{
+		&SmaRequest{PeriodRequest{Period: 1}},
+		&MacdRequest{FastPeriod: 26, SlowPeriod: 12},
+		&SarRequest{Acceleration: 0.5, Maximum: 0.2},
+		&SplitAdjustedRequest{AggregatePeriod: "y"},
+	}
+	for _, r := range invalid {
+		if _, err := NewTechnicalParams("test-token", "AAPL", "US", r, nil); err == nil {
+			t.Errorf("expected validation error for %s", r.Function())
+		}
+	}
+	if _, err := NewTechnicalParams("test-token", "AAPL", "US", &RsiRequest{PeriodRequest{Period: 14}}, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTechnicalValue_UnmarshalJSON(t *testing.T) {
+	var rows []*TechnicalValue
+	if err := json.Unmarshal([]byte(`[{"date":"2024-01-02","rsi":55.5}]`), &rows); err != nil {
+		t.Fatal(err)
+	}
+	if rows[0].Date.String() != "2024-01-02" || rows[0].Value != 55.5 {
+		t.Errorf("unexpected row %+v", rows[0])
+	}
+}
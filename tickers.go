@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"github.com/google/go-querystring/query"
 	"net/url"
+	"strings"
 )
 
 type Ticker struct {
@@ -19,10 +20,38 @@ type Ticker struct {
 	Isin     *string `json:"Isin,omitempty" csv:"Isin,omitempty"`
 }
 
+const (
+	TickerTypeCommonStock    = "common_stock"
+	TickerTypePreferredStock = "preferred_stock"
+	TickerTypeStock          = "stock"
+	TickerTypeEtf            = "etf"
+	TickerTypeFund           = "fund"
+)
+
 type TickerParams struct {
 	ApiToken     string         `url:"api_token"`
 	Format       *RequestFormat `url:"fmt"`
 	ExchangeCode *string        `url:"-"`
+	Delisted     int            `url:"delisted,omitempty"`
+	Type         *string        `url:"type,omitempty"`
+}
+
+// TickerOptions holds the optional filters of the symbol list.
+type TickerOptions struct {
+	// Delisted lists the delisted symbols instead of the active ones.
+	Delisted bool
+	Type     *string
+}
+
+// SetOptions applies the delisted and type filters to the params.
+func (t *TickerParams) SetOptions(opts *TickerOptions) {
+	if opts == nil {
+		return
+	}
+	if opts.Delisted {
+		t.Delisted = 1
+	}
+	t.Type = opts.Type
 }
 
 func NewTickerParamsDefault(apiToken string) *TickerParams {
@@ -73,6 +102,10 @@ func NewTickerService(c RequestClient) *TickerService {
 }
 
 func (t *TickerService) GetTickers(exchangeCode string, format *RequestFormat) ([]*Ticker, *Response, error) {
+	return t.GetTickersWithOptions(exchangeCode, format, nil)
+}
+
+func (t *TickerService) GetTickersWithOptions(exchangeCode string, format *RequestFormat, opts *TickerOptions) ([]*Ticker, *Response, error) {
 	var reqForm RequestFormat
 	if format == nil {
 		reqForm = formatCSV
@@ -81,6 +114,7 @@ func (t *TickerService) GetTickers(exchangeCode string, format *RequestFormat) (
 	}
 
 	params := NewTickerParams(t.c.GetApiToken(), exchangeCode, reqForm)
+	params.SetOptions(opts)
 	u, err := params.BuildPath(t.c.GetBaseUrl())
 	if err != nil {
 		return nil, nil, err
@@ -99,3 +133,57 @@ func (t *TickerService) GetTickers(exchangeCode string, format *RequestFormat) (
 
 	return data, res, nil
 }
+
+// UniverseTicker is a ticker of an exchange universe, flagged when it is no longer listed.
+type UniverseTicker struct {
+	*Ticker
+	Delisted bool
+}
+
+// GetUniverse returns the active and the delisted tickers of an exchange as one list,
+// so that backtests over the universe are free of survivorship bias.
+func (t *TickerService) GetUniverse(exchangeCode string, tickerType *string) ([]*UniverseTicker, *Response, error) {
+	active, res, err := t.GetTickersWithOptions(exchangeCode, GetFormatJson(), &TickerOptions{Type: tickerType})
+	if err != nil {
+		return nil, res, err
+	}
+	delisted, res, err := t.GetTickersWithOptions(exchangeCode, GetFormatJson(), &TickerOptions{Delisted: true, Type: tickerType})
+	if err != nil {
+		return nil, res, err
+	}
+	return MergeTickerUniverse(active, delisted), res, nil
+}
+
+// MergeTickerUniverse merges active and delisted tickers. Codes of delisted companies are reused by new
+// listings, so a delisted ticker is only dropped when it is the same security as an active one with that
+// code, matched by ISIN or, when either has no ISIN, by name.
+func MergeTickerUniverse(active, delisted []*Ticker) []*UniverseTicker {
+	universe := make([]*UniverseTicker, 0, len(active)+len(delisted))
+	byCode := make(map[string][]*Ticker, len(active))
+	for _, a := range active {
+		key := a.Code + "." + a.Exchange
+		byCode[key] = append(byCode[key], a)
+		universe = append(universe, &UniverseTicker{Ticker: a})
+	}
+	for _, d := range delisted {
+		duplicate := false
+		for _, a := range byCode[d.Code+"."+d.Exchange] {
+			if sameSecurity(a, d) {
+				duplicate = true
+				break
+			}
+		}
+		if duplicate {
+			continue
+		}
+		universe = append(universe, &UniverseTicker{Ticker: d, Delisted: true})
+	}
+	return universe
+}
+
+func sameSecurity(a, b *Ticker) bool {
+	if a.Isin != nil && *a.Isin != "" && b.Isin != nil && *b.Isin != "" {
+		return *a.Isin == *b.Isin
+	}
+	return strings.EqualFold(strings.TrimSpace(a.Name), strings.TrimSpace(b.Name))
+}
@@ -17,3 +17,41 @@ func TestTickerParams_BuildPath(t *testing.T) {
 		t.Errorf("expected %s, got %s", expected, u)
 	}
 }
+
+func TestTickerParams_SetOptions(t *testing.T) {
+	p := NewTickerParams("test-token", "US", formatJson)
+	p.SetOptions(&TickerOptions{Delisted: true, Type: GetPtrString(TickerTypeEtf)})
+	baseURL, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/exchange-symbol-list/US?api_token=test-token&delisted=1&fmt=json&type=etf"
+	u, _ := p.BuildPath(baseURL)
+	if u != expected {
+		t.Errorf("expected %s, got %s", expected, u)
+	}
+}
+
+func TestMergeTickerUniverse(t *testing.T) {
+	active := []*Ticker{
+		{Code: "AAPL", Exchange: "NASDAQ", Name: "Apple Inc", Isin: GetPtrString("US0378331005")},
+		{Code: "ACME", Exchange: "NASDAQ", Name: "Acme Robotics Inc", Isin: GetPtrString("US0000000002")},
+		{Code: "TWTR", Exchange: "NYSE", Name: "Twitter Inc"},
+	}
+	delisted := []*Ticker{
+		{Code: "FB", Exchange: "NASDAQ", Name: "Facebook Inc"},
+		// a fictional code reused by an unrelated company after the first one delisted
+		{Code: "ACME", Exchange: "NASDAQ", Name: "Acme Mining Corp", Isin: GetPtrString("US0000000001")},
+		{Code: "TWTR", Exchange: "NYSE", Name: "Twitter Inc"},
+	}
+	universe := MergeTickerUniverse(active, delisted)
+	if len(universe) != 5 {
+		t.Fatalf("expected 5 tickers, got %d", len(universe))
+	}
+	if universe[3].Code != "FB" || !universe[3].Delisted {
+		t.Errorf("expected FB to be flagged delisted, got %+v", universe[3])
+	}
+	if universe[4].Name != "Acme Mining Corp" || !universe[4].Delisted {
+		t.Errorf("expected the delisted ACME to be kept, got %+v", universe[4])
+	}
+	if universe[1].Delisted {
+		t.Error("expected ACME to be active")
+	}
+}
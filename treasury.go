@@ -0,0 +1,265 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/go-querystring/query"
+	"math"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TreasuryBillRates     = "bill-rates"
+	TreasuryLongTermRates = "long-term-rates"
+	TreasuryYieldRates    = "yield-rates"
+	TreasuryRealYields    = "real-yield-rates"
+)
+
+type TreasuryParams struct {
+	ApiToken string        `url:"api_token"`
+	Format   RequestFormat `url:"fmt"`
+	Series   string        `url:"-"`
+	Year     int           `url:"filter[year],omitempty"`
+}
+
+func (t *TreasuryParams) GetEncoded() (string, error) {
+	q, err := query.Values(t)
+	if err != nil {
+		return "", err
+	}
+	return q.Encode(), nil
+}
+
+func (t *TreasuryParams) BuildPath(baseUrl *url.URL) (string, error) {
+	basePath := fmt.Sprintf("ust/%s", t.Series)
+	bURLCopy := *baseUrl
+	bURL := &bURLCopy
+	bURL = bURL.JoinPath(basePath)
+	encoded, err := t.GetEncoded()
+	if err != nil {
+		return "", err
+	}
+	bURL.RawQuery = encoded
+	return bURL.String(), nil
+}
+
+// TreasuryRate is a daily observation of one tenor, in percent.
+// Type distinguishes the quotes of bill rates and long-term rates, e.g. a bank discount from a coupon equivalent.
+type TreasuryRate struct {
+	Date  Date    `json:"date"`
+	Tenor string  `json:"tenor"`
+	Type  string  `json:"type,omitempty"`
+	Rate  float64 `json:"rate"`
+}
+
+type treasuryResponse struct {
+	Data []*TreasuryRate `json:"data"`
+}
+
+type TreasuryService struct {
+	c RequestClient
+}
+
+func NewTreasuryService(c RequestClient) *TreasuryService {
+	return &TreasuryService{
+		c: c,
+	}
+}
+
+// GetBillRates returns the daily Treasury bill rates of year, or of the current year when year is 0.
+func (t *TreasuryService) GetBillRates(year int) ([]*TreasuryRate, *Response, error) {
+	return t.get(TreasuryBillRates, year)
+}
+
+// GetLongTermRates returns the daily long-term rates of year.
+func (t *TreasuryService) GetLongTermRates(year int) ([]*TreasuryRate, *Response, error) {
+	return t.get(TreasuryLongTermRates, year)
+}
+
+// GetParYieldCurve returns the daily par yield curve rates of year.
+func (t *TreasuryService) GetParYieldCurve(year int) ([]*TreasuryRate, *Response, error) {
+	return t.get(TreasuryYieldRates, year)
+}
+
+// GetRealYieldCurve returns the daily real (TIPS) yield curve rates of year.
+func (t *TreasuryService) GetRealYieldCurve(year int) ([]*TreasuryRate, *Response, error) {
+	return t.get(TreasuryRealYields, year)
+}
+
+func (t *TreasuryService) get(series string, year int) ([]*TreasuryRate, *Response, error) {
+	if year < 0 {
+		return nil, nil, errors.New("year must not be negative")
+	}
+	params := &TreasuryParams{
+		ApiToken: t.c.GetApiToken(),
+		Format:   formatJson,
+		Series:   series,
+		Year:     year,
+	}
+
+	u, err := params.BuildPath(t.c.GetBaseUrl())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := t.c.NewGetRequest(u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var data treasuryResponse
+	res, err := t.c.Do(req, &data)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return data.Data, res, nil
+}
+
+// ParseTenor converts a tenor such as 4WK, 6M, 1MO or 10Y into years.
+func ParseTenor(tenor string) (float64, error) {
+	s := strings.ToUpper(strings.TrimSpace(tenor))
+	units := []struct {
+		suffix string
+		years  float64
+	}{
+		{"WK", 7.0 / 365.0},
+		{"W", 7.0 / 365.0},
+		{"MO", 1.0 / 12.0},
+		{"M", 1.0 / 12.0},
+		{"YR", 1},
+		{"Y", 1},
+	}
+	for _, u := range units {
+		if !strings.HasSuffix(s, u.suffix) {
+			continue
+		}
+		n, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(s, u.suffix)), 64)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid tenor %q", tenor)
+		}
+		return n * u.years, nil
+	}
+	return 0, fmt.Errorf("invalid tenor %q", tenor)
+}
+
+const (
+	InterpolateLinear = "linear"
+	InterpolateCubic  = "cubic"
+)
+
+// YieldCurvePoint is the rate in percent at a maturity in years.
+type YieldCurvePoint struct {
+	Years float64
+	Rate  float64
+}
+
+// YieldCurve interpolates rates between tenors. Rates beyond the shortest and longest tenor are held flat.
+type YieldCurve struct {
+	Date   time.Time
+	Points []YieldCurvePoint
+
+	// second derivatives of the natural cubic spline through Points
+	spline []float64
+}
+
+// NewYieldCurve builds the curve of date from rates, ignoring observations of other days.
+// When several observations share a tenor, the last one is used.
+func NewYieldCurve(rates []*TreasuryRate, date time.Time) (*YieldCurve, error) {
+	byYears := make(map[float64]float64)
+	day := date.Format(urlDateFormat)
+	for _, r := range rates {
+		if r.Date.String() != day {
+			continue
+		}
+		years, err := ParseTenor(r.Tenor)
+		if err != nil {
+			return nil, err
+		}
+		byYears[years] = r.Rate
+	}
+	if len(byYears) == 0 {
+		return nil, fmt.Errorf("no rates on %s", day)
+	}
+
+	curve := &YieldCurve{Date: date, Points: make([]YieldCurvePoint, 0, len(byYears))}
+	for years, rate := range byYears {
+		curve.Points = append(curve.Points, YieldCurvePoint{Years: years, Rate: rate})
+	}
+	sort.Slice(curve.Points, func(i, j int) bool {
+		return curve.Points[i].Years < curve.Points[j].Years
+	})
+	curve.spline = naturalSpline(curve.Points)
+	return curve, nil
+}
+
+// Rate returns the rate in percent at years using the given interpolation method.
+func (y *YieldCurve) Rate(years float64, method string) (float64, error) {
+	n := len(y.Points)
+	if n == 0 {
+		return 0, errors.New("empty yield curve")
+	}
+	if years <= y.Points[0].Years {
+		return y.Points[0].Rate, nil
+	}
+	if years >= y.Points[n-1].Years {
+		return y.Points[n-1].Rate, nil
+	}
+
+	i := sort.Search(n, func(i int) bool { return y.Points[i].Years >= years }) - 1
+	lo, hi := y.Points[i], y.Points[i+1]
+	h := hi.Years - lo.Years
+	a := (hi.Years - years) / h
+	b := (years - lo.Years) / h
+
+	switch method {
+	case InterpolateLinear:
+		return a*lo.Rate + b*hi.Rate, nil
+	case InterpolateCubic:
+		m := y.spline
+		if len(m) != n {
+			m = naturalSpline(y.Points)
+		}
+		return a*lo.Rate + b*hi.Rate + ((a*a*a-a)*m[i]+(b*b*b-b)*m[i+1])*h*h/6, nil
+	}
+	return 0, fmt.Errorf("unknown interpolation method %q", method)
+}
+
+// DiscountFactor returns the price today of 1 paid in years, compounding the interpolated rate annually.
+func (y *YieldCurve) DiscountFactor(years float64, method string) (float64, error) {
+	rate, err := y.Rate(years, method)
+	if err != nil {
+		return 0, err
+	}
+	return math.Pow(1+rate/100, -years), nil
+}
+
+// naturalSpline solves for the second derivatives of a natural cubic spline through points.
+func naturalSpline(points []YieldCurvePoint) []float64 {
+	n := len(points)
+	m := make([]float64, n)
+	if n < 3 {
+		return m
+	}
+	u := make([]float64, n)
+	for i := 1; i < n-1; i++ {
+		sig := (points[i].Years - points[i-1].Years) / (points[i+1].Years - points[i-1].Years)
+		p := sig*m[i-1] + 2
+		m[i] = (sig - 1) / p
+		d := (points[i+1].Rate-points[i].Rate)/(points[i+1].Years-points[i].Years) -
+			(points[i].Rate-points[i-1].Rate)/(points[i].Years-points[i-1].Years)
+		u[i] = (6*d/(points[i+1].Years-points[i-1].Years) - sig*u[i-1]) / p
+	}
+	m[n-1] = 0
+	for k := n - 2; k >= 0; k-- {
+		m[k] = m[k]*m[k+1] + u[k]
+	}
+	return m
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Paul Schick
+// SPDX-License-Identifier: MPL-2.0
+
+package eodhd
+
+import (
+	"encoding/json"
+	"math"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTreasuryParams_BuildPath(t *testing.T) {
+	p := &TreasuryParams{
+		ApiToken: "test-token",
+		Format:   formatJson,
+		Series:   TreasuryYieldRates,
+		Year:     2024,
+	}
+	u, _ := url.Parse("https://eodhd.com/api")
+	expected := "https://eodhd.com/api/ust/yield-rates?api_token=test-token&filter%5Byear%5D=2024&fmt=json"
+	result, err := p.BuildPath(u)
+	if err != nil {
+		t.Error(err)
+	}
+	if expected != result {
+		t.Errorf("expected %s, got %s", expected, result)
+	}
+}
+
+func TestYieldCurve(t *testing.T) {
+	var resp treasuryResponse
+	body := []byte(`{"data":[
+		{"date":"2024-01-02","tenor":"1Y","rate":4.80},
+		{"date":"2024-01-02","tenor":"2Y","rate":4.33},
+		{"date":"2024-01-02","tenor":"6M","rate":5.26},
+		{"date":"2024-01-02","tenor":"10Y","rate":3.95},
+		{"date":"2024-01-03","tenor":"10Y","rate":3.91}
+	]}`)
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatal(err)
+	}
+
+	curve, err := NewYieldCurve(resp.Data, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(curve.Points) != 4 || curve.Points[0].Years != 0.5 {
+		t.Fatalf("unexpected points %v", curve.Points)
+	}
+
+	linear, _ := curve.Rate(1.5, InterpolateLinear)
+	if math.Abs(linear-4.565) > 1e-9 {
+		t.Errorf("expected linear rate 4.565, got %f", linear)
+	}
+	for _, p := range curve.Points {
+		cubic, _ := curve.Rate(p.Years, InterpolateCubic)
+		if math.Abs(cubic-p.Rate) > 1e-9 {
+			t.Errorf("cubic rate at %f: expected %f, got %f", p.Years, p.Rate, cubic)
+		}
+	}
+	// natural spline values between the knots, solved by hand from the tridiagonal system
+	for years, expected := range map[float64]float64{1.5: 4.504893867924529, 5: 3.8115271226415093} {
+		cubic, _ := curve.Rate(years, InterpolateCubic)
+		if math.Abs(cubic-expected) > 1e-9 {
+			t.Errorf("cubic rate at %f: expected %f, got %f", years, expected, cubic)
+		}
+	}
+	if flat, _ := curve.Rate(30, InterpolateCubic); flat != 3.95 {
+		t.Errorf("expected flat extrapolation 3.95, got %f", flat)
+	}
+
+	df, _ := curve.DiscountFactor(1, InterpolateLinear)
+	if math.Abs(df-1/1.048) > 1e-9 {
+		t.Errorf("unexpected discount factor %f", df)
+	}
+
+	if _, err = ParseTenor("4WK"); err != nil {
+		t.Error(err)
+	}
+	if _, err = ParseTenor("abc"); err == nil {
+		t.Error("expected error for invalid tenor")
+	}
+}
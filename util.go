@@ -1,6 +1,13 @@
 package eodhd
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
 
 func GetPtrString(v string) *string {
 	return &v
@@ -9,3 +16,132 @@ func GetPtrString(v string) *string {
 func GetPtrTime(v time.Time) *time.Time {
 	return &v
 }
+
+// parseOptionalDate parses a YYYY-MM-DD date, returning nil for missing or empty values.
+func parseOptionalDate(v *string) (*time.Time, error) {
+	if v == nil || *v == "" || *v == "0000-00-00" {
+		return nil, nil
+	}
+	t, err := time.Parse(urlDateFormat, *v)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// Date is a calendar date in the YYYY-MM-DD format used by the API.
+// Empty, null and zero dates decode to the zero time.
+type Date struct {
+	time.Time
+}
+
+func (d *Date) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" || s == "0000-00-00" {
+		d.Time = time.Time{}
+		return nil
+	}
+	// some endpoints return full timestamps where only the day matters
+	if len(s) > len(urlDateFormat) {
+		s = s[:len(urlDateFormat)]
+	}
+	t, err := time.Parse(urlDateFormat, s)
+	if err != nil {
+		return err
+	}
+	d.Time = t
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.Format(urlDateFormat) + `"`), nil
+}
+
+func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.Format(urlDateFormat)
+}
+
+// splitDateWindow splits the inclusive range from..to into consecutive windows of at most days days.
+func splitDateWindow(from, to time.Time, days int) [][2]time.Time {
+	if days <= 0 || to.Before(from) {
+		return [][2]time.Time{{from, to}}
+	}
+	windows := make([][2]time.Time, 0)
+	for start := from; !start.After(to); start = start.AddDate(0, 0, days) {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(to) {
+			end = to
+		}
+		windows = append(windows, [2]time.Time{start, end})
+	}
+	return windows
+}
+
+// decodeIndexed walks a JSON array, or an object keyed by row index as the API returns for some lists,
+// and calls fn once per element with the decoder positioned at the element value.
+func decodeIndexed(b []byte, fn func(dec *json.Decoder) error) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok || (delim != '{' && delim != '[') {
+		return fmt.Errorf("unexpected token %v, expected array or object", tok)
+	}
+
+	for dec.More() {
+		if delim == '{' {
+			// skip the index key
+			if _, err = dec.Token(); err != nil {
+				return err
+			}
+		}
+		if err = fn(dec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FlexFloat is a number that the API sends either as a JSON number or as a string.
+// Empty strings and null decode to zero.
+type FlexFloat float64
+
+func (f *FlexFloat) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" || strings.EqualFold(s, "NA") {
+		*f = 0
+		return nil
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*f = FlexFloat(v)
+	return nil
+}
+
+// FlexBool is a flag that the API sends as a JSON boolean, a number or a string.
+type FlexBool bool
+
+func (f *FlexBool) UnmarshalJSON(b []byte) error {
+	switch strings.ToLower(strings.Trim(string(b), `"`)) {
+	case "1", "true", "yes":
+		*f = true
+	case "0", "false", "no", "", "null":
+		*f = false
+	default:
+		return fmt.Errorf("invalid boolean %s", b)
+	}
+	return nil
+}